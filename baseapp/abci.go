@@ -216,8 +216,15 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
 
+	if app.optimisticExec != nil {
+		app.startOptimisticExecution(req.Hash)
+	}
+
+	// replay the change sets missed by the resumable listeners before streaming the new block
+	app.replayStreamingChangeSets(app.deliverState.ctx, req.Header.Height)
+
 	// call the streaming service hook with the BeginBlock messages
-	for _, abciListener := range app.streamingManager.ABCIListeners {
+	for _, abciListener := range app.streamingListeners(req.Header.Height) {
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		if err := abciListener.ListenBeginBlock(ctx, req, res); err != nil {
@@ -248,7 +255,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	// call the streaming service hook with the EndBlock messages
-	for _, abciListener := range app.streamingManager.ABCIListeners {
+	for _, abciListener := range app.streamingListeners(app.deliverState.ctx.BlockHeight()) {
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		if err := abciListener.ListenEndBlock(ctx, req, res); err != nil {
@@ -355,6 +362,11 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 	}()
 
 	resp = app.processProposal(app.processProposalState.ctx, req)
+
+	if app.optimisticExec != nil && resp.IsAccepted() {
+		app.optimisticExec.recordProposal(req.Hash, req.Txs)
+	}
+
 	return resp
 }
 
@@ -405,7 +417,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	var res abci.ResponseDeliverTx
 	defer func() {
 		// call the streaming service hook with the EndBlock messages
-		for _, abciListener := range app.streamingManager.ABCIListeners {
+		for _, abciListener := range app.streamingListeners(app.deliverState.ctx.BlockHeight()) {
 			ctx := app.deliverState.ctx
 			blockHeight := ctx.BlockHeight()
 			if err := abciListener.ListenDeliverTx(ctx, req, res); err != nil {
@@ -421,7 +433,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	gInfo, result, anteEvents, err := app.deliverTx(req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -451,24 +463,44 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
 	app.deliverState.ms.Write()
+
+	// The change set is buffered before the block is committed, so it can't be lost by the
+	// listeners once the block is committed.
+	abciListeners := app.streamingManager.ABCIListeners
+	var changeSet []*storetypes.StoreKVPair
+	if len(abciListeners) > 0 {
+		changeSet = app.cms.PopStateCache()
+		if app.streamingBuffer != nil {
+			if err := app.streamingBuffer.WriteChangeSet(header.Height, changeSet); err != nil {
+				app.logger.Error("failed to buffer streaming change set", "height", header.Height, "err", err)
+			}
+		}
+	}
+
 	commitID := app.cms.Commit()
 
+	app.seedArchive(header.Height)
+
 	res := abci.ResponseCommit{
 		Data:         commitID.Hash,
 		RetainHeight: retainHeight,
 	}
 
 	// call the streaming service hook with the EndBlock messages
-	abciListeners := app.streamingManager.ABCIListeners
 	if len(abciListeners) > 0 {
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
-		changeSet := app.cms.PopStateCache()
-		for _, abciListener := range abciListeners {
+		if app.streamingBuffer != nil {
+			if err := app.streamingBuffer.WriteCommit(blockHeight, res); err != nil {
+				app.logger.Error("failed to buffer streaming commit response", "height", blockHeight, "err", err)
+			}
+		}
+		for _, abciListener := range app.streamingListeners(blockHeight) {
 			if err := abciListener.ListenCommit(ctx, res, changeSet); err != nil {
 				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
 			}
 		}
+		app.pruneStreamingBuffer(blockHeight)
 	}
 
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
@@ -488,6 +520,12 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	if app.optimisticExec != nil {
+		app.optimisticExec.reset()
+	}
+
+	app.commitAccessSets()
+
 	var halt bool
 
 	switch {
@@ -597,6 +635,11 @@ func (app *BaseApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseLi
 	}
 
 	for _, snapshot := range snapshots {
+		// delta snapshots can't be restored through state sync
+		if snapshot.Format == snapshottypes.CurrentDeltaFormat {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to list snapshots", "err", err)
@@ -708,10 +751,11 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
+	ctx, release, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err, app.trace)
 	}
+	defer release()
 
 	res, err := handler(ctx, req)
 	if err != nil {
@@ -754,12 +798,32 @@ func checkNegativeHeight(height int64) error {
 	return nil
 }
 
-// createQueryContext creates a new sdk.Context for a query, taking as args
-// the block height and whether the query needs a proof or not.
+// versionLeaser is implemented by the multistores deleting their pruned versions
+// in the background, which must be leased while they're read.
+type versionLeaser interface {
+	LeaseVersion(version int64) (release func(), err error)
+}
+
+// CreateQueryContext creates a new sdk.Context for a query, taking as args
+// the block height and whether the query needs a proof or not. The version
+// read by the context isn't leased, so it may be pruned in the background while
+// the context is used.
 func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
-	if err := checkNegativeHeight(height); err != nil {
+	ctx, release, err := app.createQueryContext(height, prove)
+	if err != nil {
 		return sdk.Context{}, err
 	}
+	release()
+
+	return ctx, nil
+}
+
+// createQueryContext is like CreateQueryContext, but it also leases the version
+// read by the context, which can't be pruned until release is called.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, func(), error) {
+	if err := checkNegativeHeight(height); err != nil {
+		return sdk.Context{}, nil, err
+	}
 
 	// use custom query multistore if provided
 	qms := app.qms
@@ -769,11 +833,11 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 
 	lastBlockHeight := qms.LatestVersion()
 	if lastBlockHeight == 0 {
-		return sdk.Context{}, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
+		return sdk.Context{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
 	}
 
 	if height > lastBlockHeight {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			errorsmod.Wrap(
 				sdkerrors.ErrInvalidHeight,
 				"cannot query with height in the future; please provide a valid height",
@@ -786,16 +850,28 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	if height <= 1 && prove {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			errorsmod.Wrap(
 				sdkerrors.ErrInvalidRequest,
 				"cannot query with proof when height <= 1; please provide a valid height",
 			)
 	}
 
-	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	cacheMS, release, err := leaseCacheMultiStore(qms, height)
+	if err != nil && app.archive != nil && app.archive.HasHeight(height) {
+		// the height was pruned from the multistore, it's served by the archive instead
+		if prove {
+			return sdk.Context{}, nil,
+				errorsmod.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"cannot query with proof at height %d; it is only available in the state archive", height,
+				)
+		}
+		release = func() {}
+		cacheMS, err = app.archive.CacheMultiStoreWithVersion(height)
+	}
 	if err != nil {
-		return sdk.Context{},
+		return sdk.Context{}, nil,
 			errorsmod.Wrapf(
 				sdkerrors.ErrInvalidRequest,
 				"failed to load state at height %d; %s (latest height: %d)", height, err, lastBlockHeight,
@@ -805,9 +881,31 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger).
 		WithMinGasPrices(app.minGasPrices).
-		WithBlockHeight(height)
+		WithBlockHeight(height).
+		WithStoreGasConfigs(app.storeGasConfigs)
 
-	return ctx, nil
+	return ctx, release, nil
+}
+
+// leaseCacheMultiStore branches the multistore at height, leasing the version if
+// the multistore deletes its pruned versions in the background.
+func leaseCacheMultiStore(qms storetypes.MultiStore, height int64) (storetypes.CacheMultiStore, func(), error) {
+	leaser, ok := qms.(versionLeaser)
+	if !ok {
+		cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+		return cacheMS, func() {}, err
+	}
+
+	release, err := leaser.LeaseVersion(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	return cacheMS, release, nil
 }
 
 // GetBlockRetentionHeight returns the height for which all blocks below this height
@@ -917,6 +1015,9 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.version),
 			}
 
+		case "access_set":
+			return app.queryAccessSet(req)
+
 		default:
 			return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path), app.trace)
 		}
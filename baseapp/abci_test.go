@@ -805,6 +805,10 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 		var simRes sdk.SimulationResponse
 		require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
 
+		// gas profiling is off, the nil gas profile is JSON encoded as an empty one
+		require.Nil(t, gInfo.GasProfile)
+		require.Empty(t, simRes.GasProfile)
+		simRes.GasProfile = nil
 		require.Equal(t, gInfo, simRes.GasInfo)
 		require.Equal(t, result.Log, simRes.Result.Log)
 		require.Equal(t, result.Events, simRes.Result.Events)
@@ -815,6 +819,79 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_Query_SimulateTx_GasProfile(t *testing.T) {
+	suite := newOptimisticTestSuite(t, baseapp.SetGasProfiling(true))
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+
+	msg := &baseapptestutil.MsgKeyValue{Key: []byte("counter-0"), Value: []byte("value")}
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	setTxSignature(t, builder, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	gInfo, _, err := suite.baseApp.Simulate(txBytes)
+	require.NoError(t, err)
+
+	// the message reads and writes its key, and iterates over the counters
+	var operations []string
+	var total uint64
+	for _, entry := range gInfo.GasProfile {
+		require.Equal(t, capKey2.Name(), entry.StoreKey)
+		require.Equal(t, sdk.MsgTypeURL(msg), entry.MsgTypeUrl)
+		operations = append(operations, entry.Operation)
+		total += entry.Gas
+	}
+	require.Equal(t, []string{sdk.GasOperationIter, sdk.GasOperationRead, sdk.GasOperationWrite}, operations)
+	// the gas consumed by the message handler for each iterated value isn't a KV store operation
+	require.Equal(t, gInfo.GasUsed-uint64(len(msg.Value)), total)
+
+	queryResult := suite.baseApp.Query(abci.RequestQuery{Path: "/app/simulate", Data: txBytes})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+
+	var simRes sdk.SimulationResponse
+	require.NoError(t, jsonpb.Unmarshal(strings.NewReader(string(queryResult.Value)), &simRes))
+	require.Equal(t, gInfo, simRes.GasInfo)
+
+	// the delivered txs aren't affected
+	require.True(t, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	// no profile is returned when gas profiling is disabled
+	gInfo, _, err = newOptimisticTestSuite(t).baseApp.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Nil(t, gInfo.GasProfile)
+}
+
+func TestABCI_Query_SimulateTx_StoreGasConfig(t *testing.T) {
+	msg := &baseapptestutil.MsgKeyValue{Key: []byte("counter-0"), Value: []byte("value")}
+	simulate := func(opts ...func(*baseapp.BaseApp)) sdk.GasInfo {
+		suite := newOptimisticTestSuite(t, opts...)
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		setTxSignature(t, builder, 0)
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		gInfo, _, err := suite.baseApp.Simulate(txBytes)
+		require.NoError(t, err)
+		return gInfo
+	}
+
+	gasConfig := storetypes.KVGasConfig()
+	gasConfig.WriteCostFlat *= 3
+	defaultInfo := simulate()
+	customInfo := simulate(func(bapp *baseapp.BaseApp) { bapp.SetStoreGasConfig(capKey2, gasConfig) })
+
+	// the message handler writes its key once
+	require.Equal(t, defaultInfo.GasUsed+2*storetypes.KVGasConfig().WriteCostFlat, customInfo.GasUsed)
+
+	// setting a store gas config on a sealed app panics
+	suite := newOptimisticTestSuite(t)
+	require.Panics(t, func() { suite.baseApp.SetStoreGasConfig(capKey2, gasConfig) })
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
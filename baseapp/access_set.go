@@ -0,0 +1,108 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/accesskv"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// accessSets holds the access sets of the delivered transactions, by tx hash, for the block being
+// executed and the last committed block.
+type accessSets struct {
+	mtx   sync.Mutex
+	block map[string]accesskv.AccessSet
+	last  map[string]accesskv.AccessSet
+}
+
+func newAccessSets() *accessSets {
+	return &accessSets{block: make(map[string]accesskv.AccessSet), last: make(map[string]accesskv.AccessSet)}
+}
+
+// SetAccessSetTracing enables/disables the tracing of the keys read and written by each
+// delivered transaction. The access sets of the transactions of the last committed block are
+// served by the /app/access_set query.
+func (app *BaseApp) SetAccessSetTracing(enable bool) {
+	if app.sealed {
+		panic("SetAccessSetTracing() on sealed BaseApp")
+	}
+
+	app.accessSets = nil
+	if enable {
+		app.accessSets = newAccessSets()
+	}
+}
+
+// trackAccesses wraps the multistore of ctx so the accesses of the transaction are recorded in
+// the returned tracker, if access set tracing is enabled.
+func (app *BaseApp) trackAccesses(ctx sdk.Context) (sdk.Context, *accesskv.Tracker) {
+	if app.accessSets == nil {
+		return ctx, nil
+	}
+
+	tracker := accesskv.NewTracker()
+	return ctx.WithMultiStore(accesskv.NewMultiStore(ctx.MultiStore(), tracker)), tracker
+}
+
+// runDeliverTx runs a transaction sequentially in DeliverTx mode, recording its access set.
+func (app *BaseApp) runDeliverTx(txBytes []byte) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+	ctx, tracker := app.trackAccesses(app.getContextForTx(runTxModeDeliver, txBytes))
+	gInfo, result, anteEvents, _, err := app.runTxWithContext(ctx, app.mempool, runTxModeDeliver, txBytes)
+	app.recordAccessSet(txBytes, tracker)
+	return gInfo, result, anteEvents, err
+}
+
+// recordAccessSet records the access set of a delivered transaction.
+func (app *BaseApp) recordAccessSet(txBytes []byte, tracker *accesskv.Tracker) {
+	if app.accessSets == nil || tracker == nil {
+		return
+	}
+
+	app.accessSets.mtx.Lock()
+	defer app.accessSets.mtx.Unlock()
+	app.accessSets.block[string(tmhash.Sum(txBytes))] = tracker.AccessSet()
+}
+
+// commitAccessSets makes the access sets of the block the ones served by queries, it's called on
+// Commit.
+func (app *BaseApp) commitAccessSets() {
+	if app.accessSets == nil {
+		return
+	}
+
+	app.accessSets.mtx.Lock()
+	defer app.accessSets.mtx.Unlock()
+	app.accessSets.last, app.accessSets.block = app.accessSets.block, make(map[string]accesskv.AccessSet)
+}
+
+// queryAccessSet returns the JSON encoded access set of the transaction of the last committed
+// block with the hash given as the request data.
+func (app *BaseApp) queryAccessSet(req abci.RequestQuery) abci.ResponseQuery {
+	if app.accessSets == nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "access set tracing is disabled"), app.trace)
+	}
+
+	app.accessSets.mtx.Lock()
+	accessSet, ok := app.accessSets.last[string(req.Data)]
+	app.accessSets.mtx.Unlock()
+	if !ok {
+		return sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrNotFound, "no access set for tx %X in the last block", req.Data), app.trace)
+	}
+
+	bz, err := json.Marshal(accessSet)
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(err, "failed to JSON encode access set"), app.trace)
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    app.LastBlockHeight(),
+		Value:     bz,
+	}
+}
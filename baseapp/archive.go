@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/store/archive"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	ArchiveTomlKey       = "archive"
+	ArchiveEnableTomlKey = "enable"
+)
+
+// registerArchive registers the state archive of all the given stores, if enabled by the
+// [archive] configuration. The archive is fed the change sets of the blocks as a resumable ABCI
+// listener.
+func (app *BaseApp) registerArchive(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) (bool, error) {
+	if !cast.ToBool(appOpts.Get(fmt.Sprintf("%s.%s", ArchiveTomlKey, ArchiveEnableTomlKey))) {
+		return false, nil
+	}
+
+	db, err := dbm.NewDB("archive", streamingDBBackend(appOpts), filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+	if err != nil {
+		return false, err
+	}
+
+	archivedKeys := exposeStoreKeysSorted([]string{"*"}, keys)
+	store, err := archive.NewStore(db, archivedKeys)
+	if err != nil {
+		return false, err
+	}
+
+	app.cms.AddListeners(archivedKeys)
+	app.addABCIListener(store)
+	app.SetArchive(store)
+	return true, nil
+}
+
+// seedArchive starts seeding the archive in the background with the state at height, the height
+// of the block just committed, when the archive can't archive its change set: either because it
+// was enabled on an existing chain or because it missed blocks which couldn't be replayed. The
+// version is leased until the seeding completes, and the archive queues the change sets of the
+// blocks committed meanwhile. A failed seeding is retried at a later height.
+func (app *BaseApp) seedArchive(height int64) {
+	if app.archive == nil || app.archive.Seeding() || app.archive.LatestHeight() >= height-1 {
+		return
+	}
+
+	cms, release, err := leaseCacheMultiStore(app.cms, height)
+	if err != nil {
+		app.logger.Error("failed to seed the state archive", "height", height, "err", err)
+		return
+	}
+
+	app.logger.Info("seeding the state archive", "height", height, "latest_archived_height", app.archive.LatestHeight())
+	err = app.archive.SeedAsync(height, cms, func(err error) {
+		release()
+		if err != nil {
+			app.logger.Error("failed to seed the state archive", "height", height, "err", err)
+			return
+		}
+		app.logger.Info("seeded the state archive", "height", height)
+	})
+	if err != nil {
+		release()
+		app.logger.Error("failed to seed the state archive", "height", height, "err", err)
+	}
+}
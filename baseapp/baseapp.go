@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/archive"
+	"cosmossdk.io/store/listenkv"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
@@ -144,6 +146,33 @@ type BaseApp struct { //nolint: maligned
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// streamingBuffer keeps the change sets of the recent blocks, to replay them to the
+	// ResumableABCIListeners which missed them. It is nil unless enabled with SetStreamingBuffer.
+	streamingBuffer *listenkv.Buffer
+	// streamingBufferMaxBlocks is the maximum number of blocks kept by streamingBuffer, 0 for
+	// no limit.
+	streamingBufferMaxBlocks int64
+
+	// archive keeps the history of the state to serve the queries at heights pruned from the
+	// multistore. It is nil unless enabled with SetArchive.
+	archive *archive.Store
+
+	// optimisticExec executes the transactions of a block in parallel ahead of
+	// DeliverTx, it is nil unless enabled with SetOptimisticExecution.
+	optimisticExec *optimisticExecutor
+
+	// accessSets holds the keys read and written by the delivered transactions, it is nil
+	// unless enabled with SetAccessSetTracing.
+	accessSets *accessSets
+
+	// gasProfiling enables the profiling of the gas consumed by the KV store
+	// operations of the delivered and simulated transactions.
+	gasProfiling bool
+
+	// storeGasConfigs overrides the gas config of the KV stores, by store key
+	// name, set with SetStoreGasConfig.
+	storeGasConfigs map[string]storetypes.GasConfig
+
 	chainID string
 }
 
@@ -413,8 +442,10 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 func (app *BaseApp) setState(mode runTxMode, header cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	baseState := &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, false, app.logger).
+			WithStreamingManager(app.streamingManager).
+			WithStoreGasConfigs(app.storeGasConfigs),
 	}
 
 	switch mode {
@@ -616,12 +647,20 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), app.mempool, mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, using the provided
+// Context and mempool instead of the ones of the given mode's state. It is
+// used by the optimistic executor to run transactions against a branch of the
+// DeliverTx state.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mp mempool.Mempool, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	ctx = app.profileGas(ctx, mode)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -636,6 +675,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
+		reportGasProfile(ctx.GasProfiler(), mode, &gInfo)
 	}()
 
 	blockGasConsumed := false
@@ -714,12 +754,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	}
 
 	if mode == runTxModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		err = mp.Insert(ctx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, priority, err
 		}
 	} else if mode == runTxModeDeliver {
-		err = app.mempool.Remove(tx)
+		err = mp.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents, priority,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -790,8 +830,13 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
 
+		msgCtx := ctx
+		if profiler := ctx.GasProfiler(); profiler != nil {
+			msgCtx = ctx.WithGasProfiler(profiler, sdk.MsgTypeURL(msg))
+		}
+
 		// ADR 031 request type routing
-		msgResult, err := handler(ctx, msg)
+		msgResult, err := handler(msgCtx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -0,0 +1,55 @@
+package baseapp
+
+import (
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetGasProfiling enables/disables the profiling of the gas consumed by the KV store operations
+// of the transactions, by store, operation and message type. The profiles of the delivered
+// transactions are emitted as telemetry, and the profiles of the simulated transactions are
+// returned in their GasInfo.
+func (app *BaseApp) SetGasProfiling(enable bool) {
+	if app.sealed {
+		panic("SetGasProfiling() on sealed BaseApp")
+	}
+
+	app.gasProfiling = enable
+}
+
+// profileGas sets a gas profiler on ctx if gas profiling is enabled for the mode.
+func (app *BaseApp) profileGas(ctx sdk.Context, mode runTxMode) sdk.Context {
+	if !app.gasProfiling || (mode != runTxModeDeliver && mode != runTxModeSimulate) {
+		return ctx
+	}
+
+	return ctx.WithGasProfiler(sdk.NewGasProfiler(), "")
+}
+
+// reportGasProfile emits the gas profile of a delivered transaction as telemetry, or adds the
+// gas profile of a simulated transaction to its GasInfo.
+func reportGasProfile(profiler *sdk.GasProfiler, mode runTxMode, gInfo *sdk.GasInfo) {
+	if profiler == nil {
+		return
+	}
+
+	profile := profiler.Profile()
+	if mode == runTxModeSimulate {
+		gInfo.GasProfile = profile
+		return
+	}
+
+	for _, entry := range profile {
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "gas", "profile"},
+			float32(entry.Gas),
+			[]metrics.Label{
+				telemetry.NewLabel("store", entry.StoreKey),
+				telemetry.NewLabel("operation", entry.Operation),
+				telemetry.NewLabel("msg_type", entry.MsgTypeUrl),
+			},
+		)
+	}
+}
@@ -0,0 +1,392 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	"cosmossdk.io/store/accesskv"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// storeKeysByNamer is implemented by commit multi-stores, such as
+// rootmulti.Store, which expose the keys of their mounted stores.
+type storeKeysByNamer interface {
+	StoreKeysByName() map[string]storetypes.StoreKey
+}
+
+// optimisticResult is the outcome of executing a single transaction against a
+// tracked branch of the DeliverTx state.
+type optimisticResult struct {
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+
+	// access holds the keys read and the writes made by the transaction.
+	access accessSet
+	// blockGas is the gas the transaction consumed from the block gas meter.
+	blockGas uint64
+	// tracker holds the access set of the transaction if access set tracing is
+	// enabled. Unlike access, it includes the reads served by the tx branches.
+	tracker *accesskv.Tracker
+	// removedTx is the transaction runTx removed from the mempool, if any.
+	removedTx sdk.Tx
+	// valid is false if the transaction observed state that is not tracked by
+	// the access set, in which case it must be re-executed.
+	valid bool
+}
+
+// optimisticExecutor executes the transactions of a block speculatively and in
+// parallel before they are delivered. Each transaction runs against its own
+// branch of the DeliverTx state, as it is at the end of BeginBlock, and records
+// the keys it reads and writes.
+//
+// As the transactions are delivered, in order, the result of each transaction
+// is validated against the keys written by the transactions delivered before
+// it. If none of the keys it read were written, its result is identical to the
+// result of a sequential execution and its writes are applied to the DeliverTx
+// state. Otherwise the transaction is re-executed on top of the DeliverTx state.
+//
+// The block's transactions are only known ahead of DeliverTx through
+// ProcessProposal. Blocks which were not seen in ProcessProposal, e.g. while
+// block syncing, are executed sequentially.
+//
+// Transactions must not rely on state other than the stores, such as in-memory
+// caches of keepers or the consumed block gas, since such accesses are not
+// tracked and would break the equivalence with sequential execution.
+type optimisticExecutor struct {
+	workers int
+
+	// proposals holds the transactions of the proposals accepted in
+	// ProcessProposal, keyed by block hash.
+	proposals map[string][][]byte
+
+	// txs and results hold the transactions of the current block and their
+	// optimistic execution results.
+	txs     [][]byte
+	results []*optimisticResult
+	// next is the index of the next transaction expected in DeliverTx.
+	next int
+	// written holds the keys written in the current block since BeginBlock.
+	written map[storetypes.StoreKey]map[string]struct{}
+}
+
+func newOptimisticExecutor(workers int) *optimisticExecutor {
+	return &optimisticExecutor{
+		workers:   workers,
+		proposals: make(map[string][][]byte),
+	}
+}
+
+// SetOptimisticExecution enables the optimistic, parallel execution of the
+// transactions of a block using the given number of workers. A value of zero
+// disables it.
+func (app *BaseApp) SetOptimisticExecution(workers int) {
+	if app.sealed {
+		panic("SetOptimisticExecution() on sealed BaseApp")
+	}
+
+	if workers <= 0 {
+		app.optimisticExec = nil
+		return
+	}
+
+	app.optimisticExec = newOptimisticExecutor(workers)
+}
+
+// recordProposal stores the transactions of a proposal accepted in
+// ProcessProposal, such that they can be executed optimistically if the
+// proposal is the one committed.
+func (oe *optimisticExecutor) recordProposal(hash []byte, txs [][]byte) {
+	oe.proposals[string(hash)] = txs
+}
+
+// reset clears all state of the executor, it is called on Commit.
+func (oe *optimisticExecutor) reset() {
+	oe.proposals = make(map[string][][]byte)
+	oe.txs, oe.results, oe.next, oe.written = nil, nil, 0, nil
+}
+
+// startOptimisticExecution executes the transactions of the block with the
+// given hash in parallel, if they were recorded in ProcessProposal. It must be
+// called at the end of BeginBlock.
+func (app *BaseApp) startOptimisticExecution(hash []byte) {
+	oe := app.optimisticExec
+	txs, ok := oe.proposals[string(hash)]
+	oe.proposals = make(map[string][][]byte)
+
+	if !ok || len(txs) == 0 || app.deliverState.ms.TracingEnabled() {
+		return
+	}
+
+	// Transactions observe the event manager of the DeliverTx context if the
+	// AnteHandler fails, do not execute optimistically if it is non-empty.
+	if len(app.deliverState.ctx.EventManager().Events()) > 0 {
+		return
+	}
+
+	cms, ok := app.cms.(storeKeysByNamer)
+	if !ok {
+		app.logger.Error("optimistic execution requires a multi-store exposing its store keys")
+		return
+	}
+
+	keys := cms.StoreKeysByName()
+	results := make([]*optimisticResult, len(txs))
+	indexes := make(chan int, len(txs))
+	for i := range txs {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < oe.workers && w < len(txs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = app.executeOptimistically(keys, txs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	oe.txs, oe.results, oe.next = txs, results, 0
+	oe.written = make(map[storetypes.StoreKey]map[string]struct{}, len(keys))
+}
+
+// executeOptimistically runs a transaction against a tracked branch of the
+// DeliverTx state, using a no-op mempool and its own gas meters. It is safe to
+// call concurrently as long as the DeliverTx state is not written.
+func (app *BaseApp) executeOptimistically(keys map[string]storetypes.StoreKey, txBytes []byte) (res *optimisticResult) {
+	res = &optimisticResult{}
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Debug("optimistic execution panicked", "err", r)
+			res.valid = false
+		}
+	}()
+
+	ms, access := newAccessTrackingMultiStore(app.deliverState.ms, keys)
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(ms).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	// The gas meter and event manager of the DeliverTx context are shared by
+	// all transactions of the block and are not tracked. They are replaced by
+	// the AnteHandler, the transaction must be re-executed if it used them.
+	gasMeter := &observedGasMeter{GasMeter: storetypes.NewInfiniteGasMeter()}
+	blockGasMeter := storetypes.NewInfiniteGasMeter()
+	eventManager := sdk.NewEventManager()
+
+	ctx = ctx.
+		WithGasMeter(gasMeter).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(eventManager)
+
+	ctx, res.tracker = app.trackAccesses(ctx)
+
+	mp := &recordingMempool{}
+	res.gInfo, res.result, res.anteEvents, _, res.err = app.runTxWithContext(ctx, mp, runTxModeDeliver, txBytes)
+	ms.Write()
+
+	res.access = access
+	res.blockGas = blockGasMeter.GasConsumed()
+	res.removedTx = mp.removed
+	res.valid = !gasMeter.observed && len(eventManager.Events()) == 0
+
+	return res
+}
+
+// deliverTx executes a transaction in DeliverTx mode. If the transaction was
+// executed optimistically and its result is still valid, the result is reused
+// and its writes are applied to the DeliverTx state.
+func (app *BaseApp) deliverTx(txBytes []byte) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+	oe := app.optimisticExec
+	if oe == nil || oe.results == nil {
+		return app.runDeliverTx(txBytes)
+	}
+
+	i := oe.next
+	if i >= len(oe.txs) || !bytes.Equal(oe.txs[i], txBytes) {
+		// the delivered transactions differ from the proposal, fall back to
+		// sequential execution for the rest of the block
+		app.logger.Debug("delivered txs differ from the optimistically executed proposal", "index", i)
+		oe.txs, oe.results, oe.written = nil, nil, nil
+
+		return app.runDeliverTx(txBytes)
+	}
+
+	oe.next++
+
+	res := oe.results[i]
+	if !app.applyOptimisticResult(res) {
+		telemetry.IncrCounter(1, "tx", "optimistic", "reexecuted")
+		res = app.reexecuteTx(txBytes)
+	} else {
+		telemetry.IncrCounter(1, "tx", "optimistic", "reused")
+	}
+
+	oe.markWritten(res.access)
+	app.recordAccessSet(txBytes, res.tracker)
+
+	return res.gInfo, res.result, res.anteEvents, res.err
+}
+
+// applyOptimisticResult validates an optimistic result against the keys
+// written so far in the block and applies it to the DeliverTx state. It returns
+// false, without modifying any state, if the transaction must be re-executed.
+func (app *BaseApp) applyOptimisticResult(res *optimisticResult) bool {
+	if res == nil || !res.valid || res.access.conflicts(app.optimisticExec.written) {
+		return false
+	}
+
+	// runTx rejects the transaction if there is no block gas left and fails it
+	// if it exceeds the block gas limit, leave these cases to re-execution
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	if blockGasMeter.IsOutOfGas() || res.blockGas > blockGasMeter.Limit()-blockGasMeter.GasConsumedToLimit() {
+		return false
+	}
+
+	if res.removedTx != nil {
+		if err := app.mempool.Remove(res.removedTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return false
+		}
+	}
+
+	blockGasMeter.ConsumeGas(res.blockGas, "block gas meter")
+	applyWrites(app.deliverState.ms, res.access)
+
+	return true
+}
+
+// reexecuteTx executes a transaction on top of the DeliverTx state, tracking
+// its writes such that later optimistic results can be validated against them.
+func (app *BaseApp) reexecuteTx(txBytes []byte) *optimisticResult {
+	keys := app.cms.(storeKeysByNamer).StoreKeysByName()
+	ms, access := newAccessTrackingMultiStore(app.deliverState.ms, keys)
+
+	ctx := app.getContextForTx(runTxModeDeliver, txBytes).WithMultiStore(ms)
+
+	res := &optimisticResult{access: access, valid: true}
+	ctx, res.tracker = app.trackAccesses(ctx)
+	res.gInfo, res.result, res.anteEvents, _, res.err = app.runTxWithContext(ctx, app.mempool, runTxModeDeliver, txBytes)
+
+	ms.Write()
+	applyWrites(app.deliverState.ms, access)
+
+	return res
+}
+
+// markWritten adds the keys written in the given access set to the keys
+// written in the current block.
+func (oe *optimisticExecutor) markWritten(access accessSet) {
+	for key, sa := range access {
+		if len(sa.writes) == 0 {
+			continue
+		}
+
+		keys, ok := oe.written[key]
+		if !ok {
+			keys = make(map[string]struct{}, len(sa.writes))
+			oe.written[key] = keys
+		}
+
+		for _, w := range sa.writes {
+			keys[string(w.key)] = struct{}{}
+		}
+	}
+}
+
+// applyWrites applies the writes of an access set to the given multi-store.
+func applyWrites(ms storetypes.MultiStore, access accessSet) {
+	for key, sa := range access {
+		if len(sa.writes) == 0 {
+			continue
+		}
+
+		store := ms.GetKVStore(key)
+		for _, w := range sa.writes {
+			if w.value == nil {
+				store.Delete(w.key)
+			} else {
+				store.Set(w.key, w.value)
+			}
+		}
+	}
+}
+
+var _ mempool.Mempool = (*recordingMempool)(nil)
+
+// recordingMempool is a no-op mempool recording the transaction removed from
+// it, such that the removal can be replayed on the application's mempool once
+// the optimistic result of a transaction is applied.
+type recordingMempool struct {
+	mempool.NoOpMempool
+	removed sdk.Tx
+}
+
+// Remove implements the Mempool interface.
+func (mp *recordingMempool) Remove(tx sdk.Tx) error {
+	mp.removed = tx
+	return nil
+}
+
+var _ storetypes.GasMeter = (*observedGasMeter)(nil)
+
+// observedGasMeter is a GasMeter which records whether it was used at all.
+type observedGasMeter struct {
+	storetypes.GasMeter
+	observed bool
+}
+
+func (gm *observedGasMeter) GasConsumed() storetypes.Gas {
+	gm.observed = true
+	return gm.GasMeter.GasConsumed()
+}
+
+func (gm *observedGasMeter) GasConsumedToLimit() storetypes.Gas {
+	gm.observed = true
+	return gm.GasMeter.GasConsumedToLimit()
+}
+
+func (gm *observedGasMeter) GasRemaining() storetypes.Gas {
+	gm.observed = true
+	return gm.GasMeter.GasRemaining()
+}
+
+func (gm *observedGasMeter) Limit() storetypes.Gas {
+	gm.observed = true
+	return gm.GasMeter.Limit()
+}
+
+func (gm *observedGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	gm.observed = true
+	gm.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (gm *observedGasMeter) RefundGas(amount storetypes.Gas, descriptor string) {
+	gm.observed = true
+	gm.GasMeter.RefundGas(amount, descriptor)
+}
+
+func (gm *observedGasMeter) IsPastLimit() bool {
+	gm.observed = true
+	return gm.GasMeter.IsPastLimit()
+}
+
+func (gm *observedGasMeter) IsOutOfGas() bool {
+	gm.observed = true
+	return gm.GasMeter.IsOutOfGas()
+}
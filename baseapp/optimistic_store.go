@@ -0,0 +1,161 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var _ storetypes.KVStore = (*accessTrackingStore)(nil)
+
+// keyRange is a half-open [start, end) range of keys read through an iterator.
+// A nil start or end denotes an open bound.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains returns true if the given key falls within the range.
+func (r keyRange) contains(key []byte) bool {
+	if r.start != nil && bytes.Compare(key, r.start) < 0 {
+		return false
+	}
+
+	return r.end == nil || bytes.Compare(key, r.end) < 0
+}
+
+// kvWrite is a single write, or a deletion if value is nil.
+type kvWrite struct {
+	key, value []byte
+}
+
+// storeAccess holds the keys and ranges read from and the writes made to a
+// single KVStore by a transaction.
+type storeAccess struct {
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes []kvWrite
+}
+
+// accessSet holds the storeAccess of a transaction for every store it touched.
+type accessSet map[storetypes.StoreKey]*storeAccess
+
+// conflicts returns true if any key or range read in the access set was
+// written in the given set of written keys.
+func (as accessSet) conflicts(written map[storetypes.StoreKey]map[string]struct{}) bool {
+	for key, access := range as {
+		keys, ok := written[key]
+		if !ok || len(keys) == 0 {
+			continue
+		}
+
+		for k := range access.reads {
+			if _, ok := keys[k]; ok {
+				return true
+			}
+		}
+
+		for _, r := range access.ranges {
+			for k := range keys {
+				if r.contains([]byte(k)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// accessTrackingStore is a KVStore which records every key and range read from
+// its parent and buffers all writes instead of applying them to the parent.
+//
+// It is meant to sit below a cachekv.Store so that only reads which miss the
+// transaction's own cache are recorded and writes are only received once the
+// cache is written.
+type accessTrackingStore struct {
+	parent storetypes.KVStore
+	access *storeAccess
+}
+
+func newAccessTrackingStore(parent storetypes.KVStore) *accessTrackingStore {
+	return &accessTrackingStore{
+		parent: parent,
+		access: &storeAccess{reads: make(map[string]struct{})},
+	}
+}
+
+// GetStoreType implements the Store interface.
+func (s *accessTrackingStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements the KVStore interface.
+func (s *accessTrackingStore) Get(key []byte) []byte {
+	s.access.reads[string(key)] = struct{}{}
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (s *accessTrackingStore) Has(key []byte) bool {
+	s.access.reads[string(key)] = struct{}{}
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. The write is buffered.
+func (s *accessTrackingStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	s.access.writes = append(s.access.writes, kvWrite{key: key, value: value})
+}
+
+// Delete implements the KVStore interface. The deletion is buffered.
+func (s *accessTrackingStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	s.access.writes = append(s.access.writes, kvWrite{key: key})
+}
+
+// Iterator implements the KVStore interface.
+func (s *accessTrackingStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.access.ranges = append(s.access.ranges, keyRange{start: start, end: end})
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *accessTrackingStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.access.ranges = append(s.access.ranges, keyRange{start: start, end: end})
+	return s.parent.ReverseIterator(start, end)
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (s *accessTrackingStore) CacheWrap() storetypes.CacheWrap {
+	panic("cannot CacheWrap an access tracking store")
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *accessTrackingStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	panic("cannot CacheWrapWithTrace an access tracking store")
+}
+
+// newAccessTrackingMultiStore branches the given stores of a multi-store such
+// that all accesses to them are recorded in the returned accessSet. Writes only
+// reach the accessSet once the returned CacheMultiStore is written, and never
+// reach the parent multi-store.
+func newAccessTrackingMultiStore(
+	parent storetypes.MultiStore, keys map[string]storetypes.StoreKey,
+) (storetypes.CacheMultiStore, accessSet) {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	access := make(accessSet, len(keys))
+
+	for _, key := range keys {
+		store := newAccessTrackingStore(parent.GetKVStore(key))
+		stores[key] = store
+		access[key] = store.access
+	}
+
+	ms := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, keys, nil, nil)
+	return ms, access
+}
@@ -0,0 +1,206 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/store/accesskv"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgKeyValueAppendImpl appends the message value to the value stored under
+// the message key, making the result of a tx depend on the txs before it.
+type MsgKeyValueAppendImpl struct{}
+
+func (m MsgKeyValueAppendImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+
+	value := append(append([]byte{}, store.Get(msg.Key)...), msg.Value...)
+	store.Set(msg.Key, value)
+
+	// iterate over the counters, such that range reads are exercised as well
+	if bytes.HasPrefix(msg.Key, []byte("counter")) {
+		iter := storetypes.KVStorePrefixIterator(store, []byte("counter"))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			sdkCtx.GasMeter().ConsumeGas(uint64(len(iter.Value())), "iterate")
+		}
+	}
+
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+func newOptimisticTestSuite(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000)), nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueAppendImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	return suite
+}
+
+func TestABCI_OptimisticExecution(t *testing.T) {
+	sequential := newOptimisticTestSuite(t)
+	optimistic := newOptimisticTestSuite(t, baseapp.SetOptimisticExecution(4))
+
+	r := rand.New(rand.NewSource(42))
+
+	for height := int64(1); height <= 5; height++ {
+		txs := make([][]byte, 0, 50)
+		for i := 0; i < 50; i++ {
+			// half of the txs write to a handful of shared keys and conflict
+			// with each other, the other half writes to unique keys
+			key := fmt.Sprintf("account-%d-%d", height, i)
+			if r.Intn(2) == 0 {
+				key = fmt.Sprintf("counter-%d", r.Intn(3))
+			}
+
+			builder := sequential.txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte{byte(i)}}))
+			setTxSignature(t, builder, uint64(i))
+
+			txBytes, err := sequential.txConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+
+		hash := []byte(fmt.Sprintf("block-%d", height))
+		header := cmtproto.Header{Height: height}
+
+		res := optimistic.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Hash: hash, Height: height})
+		require.True(t, res.IsAccepted())
+
+		sequential.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: hash})
+		optimistic.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: hash})
+
+		for i, tx := range txs {
+			expected := sequential.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+			actual := optimistic.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+			require.Equal(t, expected, actual, "tx %d at height %d", i, height)
+		}
+
+		sequential.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+		optimistic.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+
+		require.Equal(t, sequential.baseApp.Commit().Data, optimistic.baseApp.Commit().Data, "app hash at height %d", height)
+	}
+}
+
+func TestABCI_OptimisticExecution_DifferentTxs(t *testing.T) {
+	sequential := newOptimisticTestSuite(t)
+	optimistic := newOptimisticTestSuite(t, baseapp.SetOptimisticExecution(2))
+
+	newTx := func(key string, nonce uint64) []byte {
+		builder := sequential.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(key)}))
+		setTxSignature(t, builder, nonce)
+
+		txBytes, err := sequential.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	proposed := [][]byte{newTx("a", 0), newTx("b", 1), newTx("c", 2)}
+	delivered := [][]byte{proposed[0], newTx("counter-0", 3), proposed[2], []byte("invalid tx")}
+
+	hash := []byte("block")
+	res := optimistic.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: proposed, Hash: hash, Height: 1})
+	require.True(t, res.IsAccepted())
+
+	header := cmtproto.Header{Height: 1}
+	sequential.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: hash})
+	optimistic.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: hash})
+
+	for _, tx := range delivered {
+		require.Equal(t,
+			sequential.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: tx}),
+			optimistic.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: tx}),
+		)
+	}
+
+	sequential.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	optimistic.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+
+	require.Equal(t, sequential.baseApp.Commit().Data, optimistic.baseApp.Commit().Data)
+}
+
+func TestABCI_AccessSetTracing(t *testing.T) {
+	sequential := newOptimisticTestSuite(t, baseapp.SetAccessSetTracing(true))
+	optimistic := newOptimisticTestSuite(t, baseapp.SetAccessSetTracing(true), baseapp.SetOptimisticExecution(2))
+
+	newTx := func(key string, nonce uint64) []byte {
+		builder := sequential.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(key)}))
+		setTxSignature(t, builder, nonce)
+
+		txBytes, err := sequential.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	txs := [][]byte{newTx("counter-0", 0), newTx("counter-1", 1), newTx("a", 2)}
+	hash := []byte("block")
+	res := optimistic.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Hash: hash, Height: 1})
+	require.True(t, res.IsAccepted())
+
+	header := cmtproto.Header{Height: 1}
+	for _, suite := range []*BaseAppSuite{sequential, optimistic} {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header, Hash: hash})
+		for _, tx := range txs {
+			require.True(t, suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: tx}).IsOK())
+		}
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+		suite.baseApp.Commit()
+	}
+
+	queryAccessSet := func(suite *BaseAppSuite, tx []byte) accesskv.AccessSet {
+		res := suite.baseApp.Query(abci.RequestQuery{Path: "/app/access_set", Data: tmhash.Sum(tx)})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, int64(1), res.Height)
+
+		var accessSet accesskv.AccessSet
+		require.NoError(t, json.Unmarshal(res.Value, &accessSet))
+		return accessSet
+	}
+
+	// the second counter tx reads the first counter through the iteration over the counters
+	accessSet := queryAccessSet(sequential, txs[1])
+	require.Equal(t, &accesskv.StoreAccess{
+		Reads:  [][]byte{[]byte("counter-0"), []byte("counter-1")},
+		Writes: [][]byte{[]byte("counter-1")},
+		Ranges: []accesskv.Range{{Start: []byte("counter"), End: []byte("countes")}},
+	}, accessSet[capKey2.Name()])
+	require.True(t, accessSet.Conflicts(queryAccessSet(sequential, txs[0])))
+	require.False(t, queryAccessSet(sequential, txs[2]).Conflicts(accessSet))
+
+	// the access sets of the optimistically executed txs are the ones of a sequential execution
+	for _, tx := range txs {
+		require.Equal(t, queryAccessSet(sequential, tx), queryAccessSet(optimistic, tx))
+	}
+
+	resQuery := sequential.baseApp.Query(abci.RequestQuery{Path: "/app/access_set", Data: tmhash.Sum([]byte("unknown"))})
+	require.False(t, resQuery.IsOK())
+	resQuery = newOptimisticTestSuite(t).baseApp.Query(abci.RequestQuery{Path: "/app/access_set", Data: tmhash.Sum(txs[0])})
+	require.Contains(t, resQuery.Log, "access set tracing is disabled")
+}
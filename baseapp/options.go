@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 
+	"cosmossdk.io/store/archive"
+	"cosmossdk.io/store/listenkv"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
@@ -76,6 +78,12 @@ func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
 }
 
+// SetBackgroundPruning enables/disables the deletion of the pruned versions in the background,
+// instead of during Commit, deleting at most rateLimit store versions per second if not zero.
+func SetBackgroundPruning(enable bool, rateLimit uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetBackgroundPruning(enable, rateLimit) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*BaseApp) {
@@ -92,6 +100,25 @@ func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
 }
 
+// SetOptimisticExecution returns a BaseApp option function that enables the
+// optimistic, parallel execution of transactions with the given number of
+// workers.
+func SetOptimisticExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetOptimisticExecution(workers) }
+}
+
+// SetGasProfiling returns a BaseApp option function that enables/disables the
+// profiling of the gas consumed by the KV store operations of the transactions.
+func SetGasProfiling(enable bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetGasProfiling(enable) }
+}
+
+// SetAccessSetTracing enables/disables the tracing of the keys read and written by each
+// delivered transaction.
+func SetAccessSetTracing(enable bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetAccessSetTracing(enable) }
+}
+
 // SetChainID sets the chain ID in BaseApp.
 func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
@@ -293,7 +320,35 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 	app.cms.SetMetrics(gatherer)
 }
 
+// SetStoreGasConfig sets the gas config of the KV store with the given key, which
+// replaces the default KV store gas config in all the contexts of the BaseApp.
+func (app *BaseApp) SetStoreGasConfig(key storetypes.StoreKey, gasConfig storetypes.GasConfig) {
+	if app.sealed {
+		panic("SetStoreGasConfig() on sealed BaseApp")
+	}
+
+	if app.storeGasConfigs == nil {
+		app.storeGasConfigs = make(map[string]storetypes.GasConfig)
+	}
+	app.storeGasConfigs[key.Name()] = gasConfig
+}
+
 // SetStreamingManager sets the streaming manager for the BaseApp.
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
 	app.streamingManager = manager
 }
+
+// SetStreamingBuffer sets the buffer keeping the change sets of the recent blocks, which are
+// replayed to the ResumableABCIListeners that missed them, keeping at most maxBlocks blocks or
+// all the blocks not delivered yet if zero.
+func (app *BaseApp) SetStreamingBuffer(buffer *listenkv.Buffer, maxBlocks int64) {
+	app.streamingBuffer = buffer
+	app.streamingBufferMaxBlocks = maxBlocks
+}
+
+// SetArchive sets the state archive serving the queries at the heights pruned from the
+// multistore. The archive must be fed the change sets of the archived stores, typically by being
+// one of the ABCIListeners of the streaming manager.
+func (app *BaseApp) SetArchive(store *archive.Store) {
+	app.archive = store
+}
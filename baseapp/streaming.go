@@ -2,23 +2,44 @@ package baseapp
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	"cosmossdk.io/store/listenkv"
 	"cosmossdk.io/store/streaming"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/streaming/file"
+	"cosmossdk.io/store/streaming/sink"
 	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	StreamingTomlKey                  = "streaming"
+	StreamingResumeTomlKey            = "resume"
+	StreamingMaxBufferedBlocksTomlKey = "max-buffered-blocks"
 	StreamingABCITomlKey              = "abci"
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey            = "file"
+	StreamingFileEnableTomlKey      = "enable"
+	StreamingFileDirTomlKey         = "dir"
+	StreamingFilePrefixTomlKey      = "prefix"
+	StreamingFileMaxFileSizeTomlKey = "max-file-size"
+	StreamingFileFsyncTomlKey       = "fsync"
+
+	StreamingSinkTomlKey            = "sink"
+	StreamingSinkNameTomlKey        = "name"
+	StreamingSinkTopicPrefixTomlKey = "topic-prefix"
+	StreamingSinkOptionsTomlKey     = "options"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -40,6 +61,28 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	fileRegistered, err := app.registerFileListener(appOpts, keys)
+	if err != nil {
+		return fmt.Errorf("failed to register streaming file listener: %w", err)
+	}
+	sinkRegistered, err := app.registerSinkListener(appOpts, keys)
+	if err != nil {
+		return fmt.Errorf("failed to register streaming sink listener: %w", err)
+	}
+
+	archiveRegistered, err := app.registerArchive(appOpts, keys)
+	if err != nil {
+		return fmt.Errorf("failed to register state archive: %w", err)
+	}
+
+	if (fileRegistered || sinkRegistered || archiveRegistered) && cast.ToBool(appOpts.Get(streamingTomlKey(StreamingResumeTomlKey))) {
+		db, err := dbm.NewDB("streaming-buffer", streamingDBBackend(appOpts), filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+		if err != nil {
+			return fmt.Errorf("failed to open the streaming buffer: %w", err)
+		}
+		app.SetStreamingBuffer(listenkv.NewBuffer(db), cast.ToInt64(appOpts.Get(streamingTomlKey(StreamingMaxBufferedBlocksTomlKey))))
+	}
+
 	return nil
 }
 
@@ -72,12 +115,125 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
 }
 
+// registerFileListener registers the in-process listener writing the streamed blocks to local
+// files, if enabled by the [streaming.file] configuration.
+func (app *BaseApp) registerFileListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) (bool, error) {
+	if !cast.ToBool(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileEnableTomlKey))) {
+		return false, nil
+	}
+
+	dir := cast.ToString(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileDirTomlKey)))
+	if dir == "" {
+		dir = filepath.Join("data", "streaming")
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+	prefix := cast.ToString(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFilePrefixTomlKey)))
+	if prefix == "" {
+		prefix = file.DefaultPrefix
+	}
+
+	listener, _, err := file.NewListener(file.Options{
+		Dir:         dir,
+		Prefix:      prefix,
+		MaxFileSize: cast.ToInt64(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileMaxFileSizeTomlKey))),
+		Fsync:       file.FsyncPolicy(cast.ToString(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileFsyncTomlKey)))),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, app.addRecordListener(appOpts, StreamingFileTomlKey, keys, listener, filepath.Join(dir, prefix+".offset"))
+}
+
+// registerSinkListener registers the in-process listener sending the streamed blocks to the sink
+// selected by the [streaming.sink] configuration, which must be registered with sink.Register.
+func (app *BaseApp) registerSinkListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) (bool, error) {
+	name := strings.TrimSpace(cast.ToString(appOpts.Get(streamingTomlKey(StreamingSinkTomlKey, StreamingSinkNameTomlKey))))
+	if name == "" {
+		return false, nil
+	}
+
+	s, err := sink.New(name, cast.ToStringMapString(appOpts.Get(streamingTomlKey(StreamingSinkTomlKey, StreamingSinkOptionsTomlKey))))
+	if err != nil {
+		return false, err
+	}
+
+	listener := sink.NewListener(s, cast.ToString(appOpts.Get(streamingTomlKey(StreamingSinkTomlKey, StreamingSinkTopicPrefixTomlKey))))
+	offsetFile := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", fmt.Sprintf("streaming-sink-%s.offset", name))
+	return true, app.addRecordListener(appOpts, StreamingSinkTomlKey, keys, listener, offsetFile)
+}
+
+// addRecordListener configures a record listener from the configuration of the given streaming
+// service, persisting its offset in offsetFile if streaming resumability is enabled, and adds it
+// to the streaming manager of the BaseApp.
+func (app *BaseApp) addRecordListener(
+	appOpts servertypes.AppOptions,
+	service string,
+	keys map[string]*storetypes.KVStoreKey,
+	listener *streamingabci.RecordListener,
+	offsetFile string,
+) error {
+	listener.
+		WithStoreKeys(app.addStreamingListeners(appOpts, service, keys)...).
+		WithStopNodeOnErr(cast.ToBool(appOpts.Get(streamingTomlKey(service, StreamingABCIStopNodeOnErrTomlKey))))
+
+	if cast.ToBool(appOpts.Get(streamingTomlKey(StreamingResumeTomlKey))) {
+		if _, err := listener.WithOffsetFile(offsetFile); err != nil {
+			return err
+		}
+	}
+
+	app.addABCIListener(listener)
+	return nil
+}
+
+// addStreamingListeners listens to the stores exposed by the keys of the given streaming
+// service, returning their names.
+func (app *BaseApp) addStreamingListeners(appOpts servertypes.AppOptions, service string, keys map[string]*storetypes.KVStoreKey) []string {
+	exposedKeys := exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(streamingTomlKey(service, StreamingABCIKeysTomlKey))), keys)
+	app.cms.AddListeners(exposedKeys)
+
+	names := make([]string, len(exposedKeys))
+	for i, key := range exposedKeys {
+		names[i] = key.Name()
+	}
+	return names
+}
+
+// addABCIListener adds a listener to the streaming manager of the BaseApp.
+func (app *BaseApp) addABCIListener(listener storetypes.ABCIListener) {
+	app.SetStreamingManager(
+		storetypes.StreamingManager{
+			ABCIListeners: append(app.streamingManager.ABCIListeners, listener),
+			StopNodeOnErr: app.streamingManager.StopNodeOnErr,
+		},
+	)
+}
+
+func streamingTomlKey(keys ...string) string {
+	return strings.Join(append([]string{StreamingTomlKey}, keys...), ".")
+}
+
+// streamingDBBackend returns the database backend of the application, like the server does.
+func streamingDBBackend(appOpts servertypes.AppOptions) dbm.BackendType {
+	backend := cast.ToString(appOpts.Get("app-db-backend"))
+	if backend == "" {
+		backend = cast.ToString(appOpts.Get("db-backend"))
+	}
+	if backend == "" {
+		return dbm.GoLevelDBBackend
+	}
+	return dbm.BackendType(backend)
+}
+
 func exposeAll(list []string) bool {
 	for _, ele := range list {
 		if ele == "*" {
@@ -109,3 +265,96 @@ func exposeStoreKeysSorted(keysStr []string, keys map[string]*storetypes.KVStore
 
 	return exposeStoreKeys
 }
+
+// replayStreamingChangeSets replays the buffered change sets of the blocks missed by the
+// resumable listeners, up to the block preceding height. The replay of a listener stops at the
+// first error, to be retried before the next block, and the listener is held back from the live
+// blocks until it caught up, see streamingListeners. A block missing from the buffer can't ever
+// be replayed, so it makes the node panic if the streaming manager stops the node on errors.
+func (app *BaseApp) replayStreamingChangeSets(ctx sdk.Context, height int64) {
+	if app.streamingBuffer == nil {
+		return
+	}
+
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		listener, ok := abciListener.(storetypes.ResumableABCIListener)
+		if !ok || listener.LastDeliveredHeight() == 0 {
+			continue
+		}
+
+		for h := listener.LastDeliveredHeight() + 1; h < height; h++ {
+			changeSet, res, found, err := app.streamingBuffer.Read(h)
+			if err == nil && !found {
+				err = fmt.Errorf("change set of block %d missing from the streaming buffer", h)
+			}
+			if err != nil {
+				app.logger.Error("Commit listening hook replay failed, the listener is held back", "height", h, "err", err)
+				if app.streamingManager.StopNodeOnErr {
+					panic(fmt.Errorf("failed to replay block %d to a streaming listener: %w", h, err))
+				}
+				break
+			}
+			if err := listener.ListenCommit(ctx.WithBlockHeight(h), res, changeSet); err != nil {
+				app.logger.Error("Commit listening hook replay failed, the listener is held back", "height", h, "err", err)
+				break
+			}
+		}
+	}
+}
+
+// streamingListeners returns the listeners the block at height is streamed to. The resumable
+// listeners still missing blocks preceding height are held back until these blocks are replayed
+// to them, as acknowledging the block at height would skip the missing blocks for good.
+func (app *BaseApp) streamingListeners(height int64) []storetypes.ABCIListener {
+	listeners := app.streamingManager.ABCIListeners
+	if app.streamingBuffer == nil {
+		return listeners
+	}
+
+	for i, abciListener := range listeners {
+		if !isLagging(abciListener, height) {
+			continue
+		}
+
+		// copy the listeners only when some of them are held back
+		live := append(make([]storetypes.ABCIListener, 0, len(listeners)), listeners[:i]...)
+		for _, abciListener := range listeners[i+1:] {
+			if !isLagging(abciListener, height) {
+				live = append(live, abciListener)
+			}
+		}
+		return live
+	}
+
+	return listeners
+}
+
+// isLagging returns true if the listener is a resumable listener which didn't deliver all the
+// blocks preceding height.
+func isLagging(abciListener storetypes.ABCIListener, height int64) bool {
+	listener, ok := abciListener.(storetypes.ResumableABCIListener)
+	return ok && listener.LastDeliveredHeight() > 0 && listener.LastDeliveredHeight() < height-1
+}
+
+// pruneStreamingBuffer removes the blocks delivered to all the resumable listeners from the
+// streaming buffer, along with the blocks exceeding its maximum number of blocks.
+func (app *BaseApp) pruneStreamingBuffer(height int64) {
+	if app.streamingBuffer == nil {
+		return
+	}
+
+	pruneHeight := height
+	for _, abciListener := range app.streamingManager.ABCIListeners {
+		listener, ok := abciListener.(storetypes.ResumableABCIListener)
+		if ok && listener.LastDeliveredHeight() > 0 && listener.LastDeliveredHeight() < pruneHeight {
+			pruneHeight = listener.LastDeliveredHeight()
+		}
+	}
+	if app.streamingBufferMaxBlocks > 0 && pruneHeight < height-app.streamingBufferMaxBlocks {
+		pruneHeight = height - app.streamingBufferMaxBlocks
+	}
+
+	if err := app.streamingBuffer.Prune(pruneHeight); err != nil {
+		app.logger.Error("failed to prune the streaming buffer", "height", pruneHeight, "err", err)
+	}
+}
@@ -1,17 +1,29 @@
 package baseapp_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/archive"
+	"cosmossdk.io/store/listenkv"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	streamingfile "cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -145,3 +157,272 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+func TestRegisterStreamingServices_File(t *testing.T) {
+	dir := t.TempDir()
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	suite := NewBaseAppSuite(t, distOpt)
+
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.enable": true,
+		"streaming.file.dir":    dir,
+		"streaming.file.keys":   []string{distKey1.Name()},
+	}
+	keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1, capKey1.Name(): capKey1}
+	require.NoError(t, suite.baseApp.RegisterStreamingServices(appOpts, keys))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	getDeliverStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte("key"), []byte("value"))
+	getDeliverStateCtx(suite.baseApp).KVStore(capKey1).Set([]byte("key"), []byte("value"))
+	suite.baseApp.EndBlock(abci.RequestEndBlock{})
+	suite.baseApp.Commit()
+
+	files, err := streamingfile.ListFiles(dir, streamingfile.DefaultPrefix)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+
+	var types []streamingabci.RecordType
+	var pairs []*storetypes.StoreKVPair
+	r := bufio.NewReader(f)
+	for {
+		typ, msg, err := streamingabci.ReadDelimitedRecord(r)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		types = append(types, typ)
+		if pair, ok := msg.(*storetypes.StoreKVPair); ok {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	require.Equal(t, []streamingabci.RecordType{
+		streamingabci.RecordBeginBlock,
+		streamingabci.RecordEndBlock,
+		streamingabci.RecordStoreKVPair,
+		streamingabci.RecordCommit,
+	}, types)
+	require.Equal(t, []*storetypes.StoreKVPair{
+		{StoreKey: distKey1.Name(), Key: []byte("key"), Value: []byte("value")},
+	}, pairs)
+}
+
+var _ storetypes.ResumableABCIListener = (*MockResumableABCIListener)(nil)
+
+// MockResumableABCIListener records the heights of the change sets it delivered, failing to
+// deliver the ones of the heights in failHeights as many times as set.
+type MockResumableABCIListener struct {
+	MockABCIListener
+	failHeights   map[int64]int
+	delivered     []int64
+	changeSets    map[int64][]*storetypes.StoreKVPair
+	lastDelivered int64
+}
+
+func (m *MockResumableABCIListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if m.failHeights[height] > 0 {
+		m.failHeights[height]--
+		return fmt.Errorf("failed to deliver block %d", height)
+	}
+	m.delivered = append(m.delivered, height)
+	m.changeSets[height] = changeSet
+	m.lastDelivered = height
+	return nil
+}
+
+func (m *MockResumableABCIListener) LastDeliveredHeight() int64 {
+	return m.lastDelivered
+}
+
+func TestABCI_ResumableListener_Replay(t *testing.T) {
+	listener := &MockResumableABCIListener{
+		MockABCIListener: NewMockABCIListener("resumable"),
+		failHeights:      map[int64]int{2: 1, 3: 1},
+		changeSets:       map[int64][]*storetypes.StoreKVPair{},
+	}
+	buffer := listenkv.NewBuffer(dbm.NewMemDB())
+	distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+	streamingOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetStreamingManager(storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{listener}})
+		bapp.SetStreamingBuffer(buffer, 0)
+		bapp.CommitMultiStore().AddListeners([]storetypes.StoreKey{distKey1})
+	}
+	suite := NewBaseAppSuite(t, distOpt, streamingOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	for height := int64(1); height <= 5; height++ {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		getDeliverStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte(fmt.Sprintf("key%d", height)), []byte("value"))
+		suite.baseApp.EndBlock(abci.RequestEndBlock{})
+		suite.baseApp.Commit()
+	}
+
+	// the blocks 2 and 3 fail to be delivered at commit, and are replayed before the next block
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, listener.delivered)
+	for height, changeSet := range listener.changeSets {
+		require.Equal(t, []*storetypes.StoreKVPair{
+			{StoreKey: distKey1.Name(), Key: []byte(fmt.Sprintf("key%d", height)), Value: []byte("value")},
+		}, changeSet)
+	}
+
+	// the delivered blocks are pruned from the buffer
+	_, _, found, err := buffer.Read(4)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, _, found, err = buffer.Read(5)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestABCI_ResumableListener_HeldBack(t *testing.T) {
+	testCases := []struct {
+		name          string
+		failHeights   map[int64]int
+		missing       bool
+		stopNodeOnErr bool
+		delivered     []int64
+	}{
+		{
+			name:        "replay failure",
+			failHeights: map[int64]int{2: 3},
+			delivered:   []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:        "missing block",
+			failHeights: map[int64]int{2: 1},
+			missing:     true,
+			delivered:   []int64{1},
+		},
+		{
+			name:          "missing block stopping the node",
+			failHeights:   map[int64]int{2: 1},
+			missing:       true,
+			stopNodeOnErr: true,
+			delivered:     []int64{1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listener := &MockResumableABCIListener{
+				MockABCIListener: NewMockABCIListener("resumable"),
+				failHeights:      tc.failHeights,
+				changeSets:       map[int64][]*storetypes.StoreKVPair{},
+			}
+			buffer := listenkv.NewBuffer(dbm.NewMemDB())
+			distOpt := func(bapp *baseapp.BaseApp) { bapp.MountStores(distKey1) }
+			streamingOpt := func(bapp *baseapp.BaseApp) {
+				bapp.SetStreamingManager(storetypes.StreamingManager{
+					ABCIListeners: []storetypes.ABCIListener{listener},
+					StopNodeOnErr: tc.stopNodeOnErr,
+				})
+				bapp.SetStreamingBuffer(buffer, 0)
+				bapp.CommitMultiStore().AddListeners([]storetypes.StoreKey{distKey1})
+			}
+			suite := NewBaseAppSuite(t, distOpt, streamingOpt)
+
+			suite.baseApp.InitChain(abci.RequestInitChain{
+				ConsensusParams: &tmproto.ConsensusParams{},
+			})
+
+			for height := int64(1); height <= 5; height++ {
+				if tc.missing && height == 3 {
+					// the block 2 the listener failed to deliver is dropped from the buffer
+					require.NoError(t, buffer.Prune(2))
+				}
+
+				beginBlock := func() {
+					suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+				}
+				if tc.stopNodeOnErr && height == 3 {
+					require.Panics(t, beginBlock)
+					break
+				}
+				beginBlock()
+				getDeliverStateCtx(suite.baseApp).KVStore(distKey1).Set([]byte(fmt.Sprintf("key%d", height)), []byte("value"))
+				suite.baseApp.EndBlock(abci.RequestEndBlock{})
+				suite.baseApp.Commit()
+			}
+
+			// the live blocks are held back while the listener misses the preceding blocks, so
+			// it never skips a block
+			require.Equal(t, tc.delivered, listener.delivered)
+		})
+	}
+}
+
+func TestABCI_Archive_Query(t *testing.T) {
+	db, archiveDB := dbm.NewMemDB(), dbm.NewMemDB()
+	var archiveStore *archive.Store
+	newApp := func(archived bool) *baseapp.BaseApp {
+		app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil, baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)))
+		app.MountStores(distKey1)
+		if archived {
+			var err error
+			archiveStore, err = archive.NewStore(archiveDB, []storetypes.StoreKey{distKey1})
+			require.NoError(t, err)
+			app.CommitMultiStore().AddListeners([]storetypes.StoreKey{distKey1})
+			app.SetStreamingManager(storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{archiveStore}})
+			app.SetArchive(archiveStore)
+		}
+		require.NoError(t, app.LoadLatestVersion())
+		return app
+	}
+	commit := func(app *baseapp.BaseApp, height int64) {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		getDeliverStateCtx(app).KVStore(distKey1).Set([]byte(fmt.Sprintf("key%02d", height)), []byte("value"))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	// the archive is enabled after height 3, and seeded in the background with the state at the
+	// first height it sees
+	app := newApp(false)
+	for height := int64(1); height <= 3; height++ {
+		commit(app, height)
+	}
+	app = newApp(true)
+	commit(app, 4)
+	require.Eventually(t, func() bool { return !archiveStore.Seeding() }, time.Second, time.Millisecond)
+	require.Equal(t, int64(4), archiveStore.EarliestHeight())
+	require.Equal(t, int64(4), archiveStore.LatestHeight())
+	for height := int64(5); height <= 30; height++ {
+		commit(app, height)
+	}
+
+	// the heights pruned from the multistore are served by the archive
+	_, err := app.CommitMultiStore().CacheMultiStoreWithVersion(20)
+	require.Error(t, err)
+	for _, height := range []int64{4, 20, 30} {
+		ctx, err := app.CreateQueryContext(height, false)
+		require.NoError(t, err)
+		store := ctx.KVStore(distKey1)
+		require.Equal(t, []byte("value"), store.Get([]byte(fmt.Sprintf("key%02d", height))))
+		require.Nil(t, store.Get([]byte(fmt.Sprintf("key%02d", height+1))))
+
+		it := store.Iterator(nil, nil)
+		var count int64
+		for ; it.Valid(); it.Next() {
+			count++
+		}
+		require.NoError(t, it.Close())
+		require.Equal(t, height, count)
+	}
+
+	_, err = app.CreateQueryContext(20, true)
+	require.ErrorContains(t, err, "only available in the state archive")
+	_, err = app.CreateQueryContext(3, false)
+	require.ErrorContains(t, err, "failed to load state at height 3")
+}
@@ -33,6 +33,12 @@ func SetCmdClientContextHandler(clientCtx Context, cmd *cobra.Command) (err erro
 	return SetCmdClientContext(cmd, clientCtx)
 }
 
+// CloseCmdClientContextHandler is to be used in a command post-hook execution to
+// close the connections dialed while reading the flags of the command's Context.
+func CloseCmdClientContextHandler(cmd *cobra.Command) error {
+	return GetClientContextFromCmd(cmd).CloseRemoteSigner()
+}
+
 // ValidateCmd returns unknown command error or Help display if help flag set
 func ValidateCmd(cmd *cobra.Command, args []string) error {
 	var unknownCmd string
@@ -124,7 +130,29 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	if clientCtx.RemoteSigner == nil || flagSet.Changed(flags.FlagRemoteSigner) {
+		remoteSignerURI, _ := flagSet.GetString(flags.FlagRemoteSigner)
+		if remoteSignerURI != "" {
+			// the flags are read several times per command, dial the remote signer
+			// only once per URI and close the connection it replaces
+			if clientCtx.remoteSignerConn == nil || clientCtx.remoteSignerConn.Target() != remoteSignerURI {
+				useInsecure, _ := flagSet.GetBool(flags.FlagRemoteSignerInsecure)
+				conn, err := dialGRPC(remoteSignerURI, useInsecure)
+				if err != nil {
+					return clientCtx, err
+				}
+
+				if err := clientCtx.CloseRemoteSigner(); err != nil {
+					return clientCtx, err
+				}
+
+				clientCtx = clientCtx.WithRemoteSigner(keyring.NewRemoteSignerClient(conn))
+				clientCtx.remoteSignerConn = conn
+			}
+		}
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) || flagSet.Changed(flags.FlagRemoteSigner) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
@@ -154,18 +182,8 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	if clientCtx.GRPCClient == nil || flagSet.Changed(flags.FlagGRPC) {
 		grpcURI, _ := flagSet.GetString(flags.FlagGRPC)
 		if grpcURI != "" {
-			var dialOpts []grpc.DialOption
-
 			useInsecure, _ := flagSet.GetBool(flags.FlagGRPCInsecure)
-			if useInsecure {
-				dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-			} else {
-				dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
-					MinVersion: tls.VersionTLS12,
-				})))
-			}
-
-			grpcClient, err := grpc.Dial(grpcURI, dialOpts...)
+			grpcClient, err := dialGRPC(grpcURI, useInsecure)
 			if err != nil {
 				return Context{}, err
 			}
@@ -176,6 +194,20 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 	return clientCtx, nil
 }
 
+// dialGRPC dials a gRPC endpoint, using TLS unless useInsecure is set.
+func dialGRPC(uri string, useInsecure bool) (*grpc.ClientConn, error) {
+	var dialOpts []grpc.DialOption
+	if useInsecure {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+		})))
+	}
+
+	return grpc.Dial(uri, dialOpts...)
+}
+
 // readQueryCommandFlags returns an updated Context with fields set based on flags
 // defined in AddQueryFlagsToCmd. An error is returned if any flag query fails.
 //
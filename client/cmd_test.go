@@ -119,3 +119,37 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestCloseCmdClientContextHandler(t *testing.T) {
+	var remoteSigner any
+
+	cmd := &cobra.Command{
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return client.SetCmdClientContextHandler(client.Context{}, cmd)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			remoteSigner = clientCtx.RemoteSigner
+			return err
+		},
+		PostRunE: func(cmd *cobra.Command, _ []string) error {
+			return client.CloseCmdClientContextHandler(cmd)
+		},
+	}
+	cmd.Flags().String(flags.FlagRemoteSigner, "", "remote signer")
+	cmd.Flags().Bool(flags.FlagRemoteSignerInsecure, false, "insecure remote signer")
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+	_ = testutil.ApplyMockIODiscardOutErr(cmd)
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=localhost:9999", flags.FlagRemoteSigner),
+		fmt.Sprintf("--%s", flags.FlagRemoteSignerInsecure),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// the remote signer is dialed once, and closed after the command ran
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	require.NotNil(t, clientCtx.RemoteSigner)
+	require.Same(t, clientCtx.RemoteSigner, remoteSigner)
+	require.Error(t, clientCtx.CloseRemoteSigner())
+}
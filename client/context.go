@@ -33,6 +33,7 @@ type Context struct {
 	Input             io.Reader
 	Keyring           keyring.Keyring
 	KeyringOptions    []keyring.Option
+	RemoteSigner      keyring.RemoteSignerClient
 	Output            io.Writer
 	OutputFormat      string
 	Height            int64
@@ -61,6 +62,10 @@ type Context struct {
 
 	// TODO: Deprecated (remove).
 	LegacyAmino *codec.LegacyAmino
+
+	// remoteSignerConn is the connection to the remote signer dialed from the
+	// --remote-signer flag, closed by CloseRemoteSigner.
+	remoteSignerConn *grpc.ClientConn
 }
 
 // WithKeyring returns a copy of the context with an updated keyring.
@@ -75,6 +80,24 @@ func (ctx Context) WithKeyringOptions(opts ...keyring.Option) Context {
 	return ctx
 }
 
+// WithRemoteSigner returns a copy of the context with an updated remote signer, which
+// signs with the remote keys of the keyring.
+func (ctx Context) WithRemoteSigner(signer keyring.RemoteSignerClient) Context {
+	ctx.RemoteSigner = signer
+	ctx.remoteSignerConn = nil
+	return ctx
+}
+
+// CloseRemoteSigner closes the connection to the remote signer dialed from the
+// --remote-signer flag, if any.
+func (ctx Context) CloseRemoteSigner() error {
+	if ctx.remoteSignerConn == nil {
+		return nil
+	}
+
+	return ctx.remoteSignerConn.Close()
+}
+
 // WithInput returns a copy of the context with an updated input.
 func (ctx Context) WithInput(r io.Reader) Context {
 	// convert to a bufio.Reader to have a shared buffer between the keyring and the
@@ -407,5 +430,10 @@ func NewKeyringFromBackend(ctx Context, backend string) (keyring.Keyring, error)
 		backend = keyring.BackendMemory
 	}
 
-	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, ctx.KeyringOptions...)
+	opts := ctx.KeyringOptions
+	if ctx.RemoteSigner != nil {
+		opts = append(append([]keyring.Option{}, opts...), keyring.WithRemoteSigner(ctx.RemoteSigner))
+	}
+
+	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, opts...)
 }
@@ -43,6 +43,12 @@ const (
 	SignModeEIP191 = "eip-191"
 )
 
+// Remote signer flags
+const (
+	FlagRemoteSigner         = "remote-signer"
+	FlagRemoteSignerInsecure = "remote-signer-insecure"
+)
+
 // List of CLI flags
 const (
 	FlagHome             = cmtcli.HomeFlag
@@ -146,6 +152,8 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagRemoteSigner, "", "The gRPC endpoint of the remote signer signing with the remote keys of the keyring")
+	flags.Bool(FlagRemoteSignerInsecure, false, "Allow the connection to the remote signer over insecure channels, if not the remote signer must use TLS")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
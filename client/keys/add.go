@@ -3,6 +3,7 @@ package keys
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -32,6 +33,7 @@ const (
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
 	flagHDPath      = "hd-path"
+	flagRemote      = "remote"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -53,6 +55,8 @@ If run with --dry-run, a key would be generated (or recovered) but not stored to
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
+Use the --remote flag to add a key held by the remote signer set with --remote-signer.
+Only its public key is stored, and its signatures are delegated to the remote signer.
 
 You can create and store a multisig key by passing the list of key names stored in a keyring
 and the minimum number of signatures required through --multisig-threshold. The keys are
@@ -71,6 +75,7 @@ Example:
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
+	f.Bool(flagRemote, false, "Store a local reference to the key with the same name of the remote signer")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
@@ -203,6 +208,27 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
+	if remote, _ := cmd.Flags().GetBool(flagRemote); remote {
+		if ctx.RemoteSigner == nil {
+			return fmt.Errorf("a remote signer is required to add a remote key, set it with the --%s flag", flags.FlagRemoteSigner)
+		}
+
+		fetchCtx, cancel := context.WithTimeout(context.Background(), keyring.RemoteSignerTimeout)
+		defer cancel()
+
+		pk, err := keyring.FetchRemoteSignerPubKey(fetchCtx, ctx.RemoteSigner, ctx.Codec, name)
+		if err != nil {
+			return err
+		}
+
+		k, err := kb.SaveRemoteKey(name, pk)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, k, false, "", outputFormat)
+	}
+
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
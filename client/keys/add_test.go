@@ -5,10 +5,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"testing"
 
 	"github.com/cometbft/cometbft/libs/cli"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -276,3 +279,66 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func Test_runAddCmdRemote(t *testing.T) {
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	cdc := clienttestutil.MakeTestCodec(t)
+
+	// the remote signer serves the keys of an in-memory keyring
+	signerKr := keyring.NewInMemory(cdc)
+	signerRecord, _, err := signerKr.NewMnemonic("keyname1", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signerPub, err := signerRecord.GetPubKey()
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	keyring.RegisterRemoteSignerServer(server, keyring.NewRemoteSignerServer(signerKr, cdc))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	args := []string{
+		"keyname1",
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", cli.OutputFlag, OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s", flagRemote),
+	}
+
+	// adding a remote key requires a remote signer
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	cmd.SetArgs(args)
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "a remote signer is required")
+
+	clientCtx = clientCtx.WithRemoteSigner(keyring.NewRemoteSignerClient(conn))
+	ctx = context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+	k, err := kb.Key("keyname1")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeOffline, k.GetType())
+	require.True(t, k.IsRemote())
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, signerPub.Equals(pub))
+
+	// the remote signer doesn't hold keyname2
+	cmd.SetArgs(append([]string{"keyname2"}, args[1:]...))
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
@@ -33,8 +33,8 @@ type AppOptions struct {
 }
 
 // RootCmd generates a root command for an app based on the AppOptions. This
-// command currently only includes query commands but will be enhanced over
-// time to cover the full scope of an app CLI.
+// command currently only includes query and tx commands but will be enhanced
+// over time to cover the full scope of an app CLI.
 func (appOptions AppOptions) RootCmd() (*cobra.Command, error) {
 	rootCmd := &cobra.Command{}
 	err := appOptions.EnhanceRootCommand(rootCmd)
@@ -42,11 +42,12 @@ func (appOptions AppOptions) RootCmd() (*cobra.Command, error) {
 }
 
 // EnhanceRootCommand enhances the provided root command with autocli AppOptions,
-// only adding missing query commands and doesn't override commands already
+// only adding missing query and tx commands and doesn't override commands already
 // in the root command. This allows for the graceful integration of autocli with
 // existing app CLI commands where autocli simply automatically adds things that
 // weren't manually provided. It does take into account custom query commands
-// provided by modules with the HasCustomQueryCommand extension interface.
+// provided by modules with the HasCustomQueryCommand and HasCustomTxCommand
+// extension interfaces.
 // Example Usage:
 //
 //	var autoCliOpts autocli.AppOptions
@@ -61,8 +62,9 @@ func (appOptions AppOptions) EnhanceRootCommand(rootCmd *cobra.Command) error {
 		GetClientConn: func(cmd *cobra.Command) (grpc.ClientConnInterface, error) {
 			return client.GetClientQueryContext(cmd)
 		},
-		AddQueryConnFlags: flags.AddQueryFlagsToCmd,
-		AddTxConnFlags:    flags.AddTxFlagsToCmd,
+		GetClientTxContext: client.GetClientTxContext,
+		AddQueryConnFlags:  flags.AddQueryFlagsToCmd,
+		AddTxConnFlags:     flags.AddTxFlagsToCmd,
 	}
 
 	return appOptions.EnhanceRootCommandWithBuilder(rootCmd, builder)
@@ -131,7 +133,7 @@ func (appOptions AppOptions) EnhanceRootCommandWithBuilder(rootCmd *cobra.Comman
 		txCmdDesc := modOpts.Tx
 		if txCmdDesc != nil {
 			subCmd := topLevelCmd(moduleName, fmt.Sprintf("Transations commands for the %s module", moduleName))
-			err := builder.AddQueryServiceCommands(cmd, txCmdDesc)
+			err := builder.AddMsgServiceCommands(subCmd, txCmdDesc)
 			if err != nil {
 				return err
 			}
@@ -142,11 +144,11 @@ func (appOptions AppOptions) EnhanceRootCommandWithBuilder(rootCmd *cobra.Comman
 	}
 
 	if msgCmd := findSubCommand(rootCmd, "tx"); msgCmd != nil {
-		if err := builder.enhanceCommandCommon(msgCmd, moduleOptions, customQueryCmds, enhanceMsg); err != nil {
+		if err := builder.enhanceCommandCommon(msgCmd, moduleOptions, customMsgCmds, enhanceMsg); err != nil {
 			return err
 		}
 	} else {
-		subCmd, err := builder.BuildMsgCommand(moduleOptions, customQueryCmds)
+		subCmd, err := builder.BuildMsgCommand(moduleOptions, customMsgCmds)
 		if err != nil {
 			return err
 		}
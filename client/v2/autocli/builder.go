@@ -1,8 +1,10 @@
 package autocli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/client/v2/autocli/flag"
 )
@@ -16,6 +18,16 @@ type Builder struct {
 	// from a given context.
 	GetClientConn func(*cobra.Command) (grpc.ClientConnInterface, error)
 
+	// GetClientTxContext specifies how CLI msg commands will resolve the client.Context used to build,
+	// sign and broadcast their txs from a given command. If it is nil client.GetClientTxContext will be used.
+	GetClientTxContext func(*cobra.Command) (client.Context, error)
+
+	// SendMsg specifies how CLI msg commands will send the msg built from their flags and positional arguments.
+	// If it is nil the msg is signed and broadcast with the client.Context returned by GetClientTxContext, which
+	// requires its type to be registered in the interface registry. Clients without the gogoproto msg types, such
+	// as those built from the file descriptors of a remote chain, can set it to sign and broadcast msgs themselves.
+	SendMsg func(cmd *cobra.Command, msg protoreflect.Message) error
+
 	AddQueryConnFlags func(*cobra.Command)
 
 	AddTxConnFlags func(*cobra.Command)
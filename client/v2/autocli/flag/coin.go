@@ -0,0 +1,196 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// coinType parses cosmos.base.v1beta1.Coin flags from strings such as 10uatom.
+type coinType struct{}
+
+func (c coinType) NewValue(context.Context, *Builder) Value {
+	return &coinValue{}
+}
+
+func (c coinType) DefaultValue() string {
+	return ""
+}
+
+type coinValue struct {
+	coin *sdk.Coin
+}
+
+func (c coinValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	if c.coin == nil {
+		return protoreflect.Value{}, nil
+	}
+	return protoreflect.ValueOfMessage((&basev1beta1.Coin{
+		Denom:  c.coin.Denom,
+		Amount: c.coin.Amount.String(),
+	}).ProtoReflect()), nil
+}
+
+func (c coinValue) String() string {
+	if c.coin == nil {
+		return ""
+	}
+	return c.coin.String()
+}
+
+func (c *coinValue) Set(s string) error {
+	decCoin, err := parseDecCoin(s)
+	if err != nil {
+		return err
+	}
+	if !decCoin.Amount.IsInteger() {
+		return fmt.Errorf("coin amount must be an integer: %s", s)
+	}
+
+	coin := sdk.NewCoin(decCoin.Denom, decCoin.Amount.TruncateInt())
+	c.coin = &coin
+	return nil
+}
+
+func (c coinValue) Type() string {
+	return "coin"
+}
+
+func (c coinValue) denom() string {
+	return c.coin.Denom
+}
+
+// decCoinType parses cosmos.base.v1beta1.DecCoin flags from strings such as 1.5uatom.
+type decCoinType struct{}
+
+func (d decCoinType) NewValue(context.Context, *Builder) Value {
+	return &decCoinValue{}
+}
+
+func (d decCoinType) DefaultValue() string {
+	return ""
+}
+
+type decCoinValue struct {
+	coin *sdk.DecCoin
+}
+
+func (d decCoinValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	if d.coin == nil {
+		return protoreflect.Value{}, nil
+	}
+
+	amountField := (&basev1beta1.DecCoin{}).ProtoReflect().Descriptor().Fields().ByName("amount")
+	return protoreflect.ValueOfMessage((&basev1beta1.DecCoin{
+		Denom:  d.coin.Denom,
+		Amount: encodeDec(d.coin.Amount, hasLegacyDecCustomType(amountField)),
+	}).ProtoReflect()), nil
+}
+
+func (d decCoinValue) String() string {
+	if d.coin == nil {
+		return ""
+	}
+	return d.coin.String()
+}
+
+func (d *decCoinValue) Set(s string) error {
+	coin, err := parseDecCoin(s)
+	if err != nil {
+		return err
+	}
+	d.coin = &coin
+	return nil
+}
+
+func (d decCoinValue) Type() string {
+	return "dec coin"
+}
+
+func (d decCoinValue) denom() string {
+	return d.coin.Denom
+}
+
+// parseDecCoin parses a single coin, refusing lists of coins.
+func parseDecCoin(s string) (sdk.DecCoin, error) {
+	if strings.Contains(s, ",") {
+		return sdk.DecCoin{}, fmt.Errorf("expected a single coin, got %s", s)
+	}
+	return sdk.ParseDecCoin(s)
+}
+
+// coinsType parses repeated Coin and DecCoin flags from comma separated lists such as 10uatom,5stake, which
+// can also be split across multiple flags or varargs. The coins are sorted by denom, as sdk.Coins are.
+type coinsType struct {
+	coinType Type
+}
+
+func (c coinsType) NewValue(ctx context.Context, opts *Builder) Value {
+	return &coinsValue{
+		coinType: c.coinType,
+		ctx:      ctx,
+		opts:     opts,
+	}
+}
+
+func (c coinsType) DefaultValue() string {
+	return ""
+}
+
+// denomValue is implemented by the values of the single coin flags.
+type denomValue interface {
+	Value
+	denom() string
+}
+
+type coinsValue struct {
+	coinType Type
+	coins    []denomValue
+	ctx      context.Context
+	opts     *Builder
+}
+
+func (c *coinsValue) Get(mutable protoreflect.Value) (protoreflect.Value, error) {
+	coins := append([]denomValue{}, c.coins...)
+	sort.SliceStable(coins, func(i, j int) bool {
+		return coins[i].denom() < coins[j].denom()
+	})
+
+	list := mutable.List()
+	for _, coin := range coins {
+		value, err := coin.Get(protoreflect.Value{})
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		list.Append(value)
+	}
+	return mutable, nil
+}
+
+func (c *coinsValue) String() string {
+	coins := make([]string, len(c.coins))
+	for i, coin := range c.coins {
+		coins[i] = coin.String()
+	}
+	return strings.Join(coins, ",")
+}
+
+func (c *coinsValue) Set(s string) error {
+	for _, part := range strings.Split(s, ",") {
+		coin := c.coinType.NewValue(c.ctx, c.opts).(denomValue)
+		if err := coin.Set(strings.TrimSpace(part)); err != nil {
+			return err
+		}
+		c.coins = append(c.coins, coin)
+	}
+	return nil
+}
+
+func (c *coinsValue) Type() string {
+	return fmt.Sprintf("%ss", c.coinType.NewValue(c.ctx, c.opts).Type())
+}
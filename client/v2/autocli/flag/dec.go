@@ -0,0 +1,98 @@
+package flag
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// gogoprotoCustomTypeField is the field number of the gogoproto.customtype field option.
+const gogoprotoCustomTypeField = 65003
+
+// decType parses cosmos.Dec scalar flags from decimal strings such as 0.05.
+type decType struct {
+	// legacyDec is set for the fields with the gogoproto LegacyDec custom type, which are encoded as the
+	// integer representation of the decimal scaled by 10^18 rather than as a decimal string.
+	legacyDec bool
+}
+
+func (d decType) NewValue(context.Context, *Builder) Value {
+	return &decValue{legacyDec: d.legacyDec}
+}
+
+func (d decType) DefaultValue() string {
+	return ""
+}
+
+type decValue struct {
+	legacyDec bool
+	dec       *sdk.Dec
+}
+
+func (d decValue) Get(protoreflect.Value) (protoreflect.Value, error) {
+	if d.dec == nil {
+		return protoreflect.Value{}, nil
+	}
+	return protoreflect.ValueOfString(encodeDec(*d.dec, d.legacyDec)), nil
+}
+
+func (d decValue) String() string {
+	if d.dec == nil {
+		return ""
+	}
+	return d.dec.String()
+}
+
+func (d *decValue) Set(s string) error {
+	dec, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return err
+	}
+	d.dec = &dec
+	return nil
+}
+
+func (d decValue) Type() string {
+	return "dec"
+}
+
+// encodeDec encodes a decimal as the string value of a cosmos.Dec field.
+func encodeDec(dec sdk.Dec, legacyDec bool) string {
+	if legacyDec {
+		return dec.BigInt().String()
+	}
+	return dec.String()
+}
+
+// hasLegacyDecCustomType returns whether the field has the gogoproto LegacyDec (formerly sdk.Dec) custom type.
+// The gogoproto extensions aren't registered, so the option is read from the encoded field options.
+func hasLegacyDecCustomType(field protoreflect.FieldDescriptor) bool {
+	bz, err := proto.Marshal(field.Options())
+	if err != nil {
+		return false
+	}
+
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return false
+		}
+		bz = bz[n:]
+
+		if num == gogoprotoCustomTypeField && typ == protowire.BytesType {
+			customType, _ := protowire.ConsumeBytes(bz)
+			return strings.HasSuffix(string(customType), "Dec")
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return false
+		}
+		bz = bz[n:]
+	}
+	return false
+}
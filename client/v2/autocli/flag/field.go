@@ -78,11 +78,14 @@ func (b *Builder) addFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, fiel
 func (b *Builder) resolveFlagType(field protoreflect.FieldDescriptor) Type {
 	typ := b.resolveFlagTypeBasic(field)
 	if field.IsList() {
-		if typ != nil {
+		switch typ.(type) {
+		case nil:
+			return nil
+		case coinType, decCoinType:
+			return coinsType{coinType: typ}
+		default:
 			return compositeListType{simpleType: typ}
 		}
-
-		return nil
 	}
 
 	return typ
@@ -93,6 +96,9 @@ func (b *Builder) resolveFlagTypeBasic(field protoreflect.FieldDescriptor) Type
 	if scalar != nil {
 		b.init()
 		if typ, ok := b.scalarFlagTypes[scalar.(string)]; ok {
+			if _, ok := typ.(decType); ok {
+				return decType{legacyDec: hasLegacyDecCustomType(field)}
+			}
 			return typ
 		}
 	}
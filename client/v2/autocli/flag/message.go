@@ -36,18 +36,16 @@ func (m MessageBinder) Bind(msg protoreflect.Message, positionalArgs []string) e
 			panic("unexpected: validate args should have caught this")
 		}
 
-		name := fmt.Sprintf("%d", i)
-		if i == n-1 && m.hasVarargs {
-			for _, v := range positionalArgs[i:] {
-				err := m.positionalFlagSet.Set(name, v)
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			err := m.positionalFlagSet.Set(name, positionalArgs[i])
-			if err != nil {
-				return err
+		values := positionalArgs[i : i+1]
+		if i == len(m.positionalArgs)-1 && m.hasVarargs {
+			values = positionalArgs[i:]
+		}
+
+		// the errors name the field of the positional arg rather than its internal flag
+		flag := m.positionalFlagSet.Lookup(fmt.Sprintf("%d", i))
+		for _, v := range values {
+			if err := flag.Value.Set(v); err != nil {
+				return fmt.Errorf("invalid argument %q for %s: %w", v, m.positionalArgs[i].field.Name(), err)
 			}
 		}
 	}
@@ -88,11 +86,10 @@ func (f fieldBinding) bind(msg protoreflect.Message) error {
 	if err != nil {
 		return err
 	}
-	kind := f.field.Kind()
-	if !(field.IsList() ||
-		field.IsMap() ||
-		kind == protoreflect.MessageKind ||
-		kind == protoreflect.GroupKind) {
+
+	// the new field value isn't attached to msg, so lists, maps and messages have to be set as well, unless they
+	// weren't provided
+	if val.IsValid() {
 		msg.Set(f.field, val)
 	}
 	return nil
@@ -4,9 +4,15 @@ import (
 	"fmt"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	"github.com/cockroachdb/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
@@ -102,26 +108,104 @@ func (b *Builder) AddMsgServiceCommands(cmd *cobra.Command, cmdDescriptor *autoc
 	return nil
 }
 
+// BuildMsgMethodCommand builds a command which generates, simulates or signs and broadcasts a tx containing the
+// msg of the provided method, depending on the tx flags. The signer field of the msg is resolved from the --from
+// flag using the keyring. If the signer field is set by a flag or positional argument, it is used as --from.
+// If the builder has a SendMsg function, the msg is passed to it instead.
 func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
-	jsonMarshalOptions := protojson.MarshalOptions{
-		Indent:          "  ",
-		UseProtoNames:   true,
-		UseEnumNumbers:  false,
-		EmitUnpopulated: true,
-		Resolver:        b.TypeResolver,
-	}
+	signerField := MsgSignerField(descriptor.Input())
 
 	cmd, err := b.buildMethodCommandCommon(descriptor, options, func(cmd *cobra.Command, input protoreflect.Message) error {
-		bz, err := jsonMarshalOptions.Marshal(input.Interface())
+		if b.SendMsg != nil {
+			return b.SendMsg(cmd, input)
+		}
+
+		if signerField != nil {
+			if signer := input.Get(signerField).String(); signer != "" && cmd.Flags().Lookup(flags.FlagFrom) != nil {
+				if err := cmd.Flags().Set(flags.FlagFrom, signer); err != nil {
+					return err
+				}
+			}
+		}
+
+		clientCtx, err := b.getClientTxContext(cmd)
 		if err != nil {
 			return err
 		}
 
-		err = b.outOrStdoutFormat(cmd, bz)
-		return err
+		if signerField != nil {
+			fromAddr := clientCtx.GetFromAddress()
+			if fromAddr.Empty() {
+				return errors.Errorf("the signer %s of %s is required, set it with the --%s flag", signerField.Name(), input.Descriptor().FullName(), flags.FlagFrom)
+			}
+			input.Set(signerField, protoreflect.ValueOfString(fromAddr.String()))
+		}
+
+		msg, err := toSdkMsg(clientCtx, input)
+		if err != nil {
+			return err
+		}
+
+		return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 	})
+	if err != nil {
+		return nil, err
+	}
+
 	if b.AddTxConnFlags != nil {
 		b.AddTxConnFlags(cmd)
 	}
-	return cmd, err
+	return cmd, nil
+}
+
+// getClientTxContext returns the client.Context used to build the txs of the provided command.
+func (b *Builder) getClientTxContext(cmd *cobra.Command) (client.Context, error) {
+	if b.GetClientTxContext != nil {
+		return b.GetClientTxContext(cmd)
+	}
+	return client.GetClientTxContext(cmd)
+}
+
+// MsgSignerField returns the string field of the msg declared as its signer by the cosmos.msg.v1.signer option, or
+// nil if there is none. Only the first signer is resolved, other signers must be provided by the user.
+func MsgSignerField(desc protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	signers, ok := proto.GetExtension(desc.Options(), msgv1.E_Signer).([]string)
+	if !ok || len(signers) == 0 {
+		return nil
+	}
+
+	field := desc.Fields().ByName(protoreflect.Name(signers[0]))
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return nil
+	}
+	return field
+}
+
+// toSdkMsg converts the provided msg to the sdk.Msg registered for its type in the interface registry of the
+// client context.
+func toSdkMsg(clientCtx client.Context, input protoreflect.Message) (sdk.Msg, error) {
+	if clientCtx.InterfaceRegistry == nil {
+		return nil, errors.New("the client context has no interface registry")
+	}
+
+	typeURL := "/" + string(input.Descriptor().FullName())
+	resolved, err := clientCtx.InterfaceRegistry.Resolve(typeURL)
+	if err != nil {
+		return nil, errors.Errorf("can't resolve msg %s: %v", typeURL, err)
+	}
+
+	msg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return nil, errors.Errorf("%s is not a sdk.Msg", typeURL)
+	}
+
+	bz, err := proto.Marshal(input.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gogoproto.Unmarshal(bz, msg); err != nil {
+		return nil, errors.Errorf("can't convert msg %s: %v", typeURL, err)
+	}
+	return msg, nil
 }
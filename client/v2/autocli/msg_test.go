@@ -1,15 +1,31 @@
 package autocli
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"google.golang.org/protobuf/encoding/protojson"
+	"io"
+	"net"
 	"strings"
 	"testing"
 
 	"gotest.tools/v3/golden"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gotest.tools/v3/assert"
 
 	"cosmossdk.io/client/v2/internal/testpb"
@@ -98,36 +114,200 @@ var testCmdMsgDesc = &autocliv1.ServiceCommandDescriptor{
 	},
 }
 
-func TestMsgOptions(t *testing.T) {
-	conn := testExecCommon(t,
-		buildModuleMsgCommand,
-		"send", "5", "6", `{"denom":"foo","amount":"1"}`,
-		"--uint32", "7",
-		"--u64", "8",
-		"--output", "json",
+var testBankMsgDesc = &autocliv1.ServiceCommandDescriptor{
+	Service: bankv1beta1.Msg_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "Send",
+			Use:       "send [from_key_or_address] [to_address] [amount...]",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{
+					ProtoField: "from_address",
+				},
+				{
+					ProtoField: "to_address",
+				},
+				{
+					ProtoField: "amount",
+					Varargs:    true,
+				},
+			},
+		},
+	},
+}
+
+type txFixture struct {
+	clientCtx client.Context
+	from      sdk.AccAddress
+	to        sdk.AccAddress
+	simulated []sdk.Tx
+}
+
+func initTxFixture(t *testing.T) *txFixture {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	kr := keyring.NewInMemory(encodingConfig.Codec)
+	record, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	assert.NilError(t, err)
+	from, err := record.GetAddress()
+	assert.NilError(t, err)
+
+	fixture := &txFixture{
+		from: from,
+		to:   sdk.AccAddress("to_address__________"),
+	}
+
+	// serve the simulations of the dry runs and gas estimations
+	grpcCodec := grpc.ForceServerCodec(codec.NewProtoCodec(encodingConfig.InterfaceRegistry).GRPCCodec())
+	server := grpc.NewServer(grpcCodec)
+	txtypes.RegisterServiceServer(server, &testTxServer{fixture: fixture, txDecoder: encodingConfig.TxConfig.TxDecoder()})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	grpcClient, err := grpc.Dial(
+		listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(encodingConfig.InterfaceRegistry).GRPCCodec())),
 	)
-	response := conn.out.String()
-	var output testpb.MsgRequest
-	err := protojson.Unmarshal([]byte(response), &output)
 	assert.NilError(t, err)
-	assert.Equal(t, output.GetU32(), uint32(7))
-	assert.Equal(t, output.GetPositional1(), int32(5))
-	assert.Equal(t, output.GetPositional2(), "6")
+	t.Cleanup(func() { _ = grpcClient.Close() })
+
+	fixture.clientCtx = client.Context{}.
+		WithKeyring(kr).
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry).
+		WithTxConfig(encodingConfig.TxConfig).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithGRPCClient(grpcClient).
+		WithChainID("test-chain")
+	return fixture
 }
 
-func TestMsgOutputFormat(t *testing.T) {
-	conn := testExecCommon(t, buildModuleMsgCommand,
-		"send", "5", "6", `{"denom":"foo","amount":"1"}`,
-		"--output", "json",
+// execMsg executes the bank msg command with the provided args and returns its output.
+func (f *txFixture) execMsg(t *testing.T, args ...string) (string, error) {
+	b := &Builder{AddTxConnFlags: flags.AddTxFlagsToCmd}
+	cmd := topLevelCmd("bank", "Transations commands for the bank module")
+	assert.NilError(t, b.AddMsgServiceCommands(cmd, testBankMsgDesc))
+
+	out := &bytes.Buffer{}
+	clientCtx := f.clientCtx.WithOutput(out)
+	cmd.SetArgs(args)
+	cmd.SetOut(out)
+	cmd.SetErr(io.Discard)
+	err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	return out.String(), err
+}
+
+// decodeTx decodes the JSON encoded tx printed by a command.
+func (f *txFixture) decodeTx(t *testing.T, out string) sdk.Tx {
+	tx, err := f.clientCtx.TxConfig.TxJSONDecoder()([]byte(out))
+	assert.NilError(t, err)
+	return tx
+}
+
+func TestMsgGenerateOnly(t *testing.T) {
+	fixture := initTxFixture(t)
+
+	// the signer is resolved from a key name provided as a positional argument
+	out, err := fixture.execMsg(t, "send", "alice", fixture.to.String(), "1bar", "10foo",
+		"--generate-only",
+		"--note", "memo",
 	)
-	assert.Assert(t, strings.Contains(conn.out.String(), "{"))
-	conn = testExecCommon(t, buildModuleMsgCommand,
-		"send", "5", "6", `{"denom":"foo","amount":"1"}`,
-		"--output", "text",
+	assert.NilError(t, err)
+
+	tx := fixture.decodeTx(t, out)
+	assert.DeepEqual(t, []sdk.Msg{&banktypes.MsgSend{
+		FromAddress: fixture.from.String(),
+		ToAddress:   fixture.to.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("bar", 1)),
+	}}, tx.GetMsgs())
+	assert.Equal(t, "memo", tx.(sdk.TxWithMemo).GetMemo())
+
+	// the signer is resolved from the --from flag
+	out, err = fixture.execMsg(t, "update-params", "--params", `{"default_send_enabled":true}`,
+		"--from", "alice",
+		"--generate-only",
 	)
+	assert.NilError(t, err)
+
+	tx = fixture.decodeTx(t, out)
+	assert.DeepEqual(t, []sdk.Msg{&banktypes.MsgUpdateParams{
+		Authority: fixture.from.String(),
+		Params:    banktypes.Params{DefaultSendEnabled: true},
+	}}, tx.GetMsgs())
+}
 
-	assert.Assert(t, strings.Contains(conn.out.String(), "positional1: 5"))
+func TestMsgMissingSigner(t *testing.T) {
+	fixture := initTxFixture(t)
 
+	_, err := fixture.execMsg(t, "update-params", "--generate-only")
+	assert.ErrorContains(t, err, "the signer authority of cosmos.bank.v1beta1.MsgUpdateParams is required")
+
+	_, err = fixture.execMsg(t, "send", "unknown", fixture.to.String(), "10foo", "--generate-only")
+	assert.ErrorContains(t, err, "unknown")
+
+	// the msg is validated before the tx is built
+	_, err = fixture.execMsg(t, "send", "alice", fixture.to.String(), "--generate-only")
+	assert.ErrorContains(t, err, "requires at least 3 arg")
+	_, err = fixture.execMsg(t, "send", "alice", fixture.to.String(), "0foo", "--generate-only")
+	assert.ErrorContains(t, err, "invalid coins")
+}
+
+func TestMsgDryRun(t *testing.T) {
+	fixture := initTxFixture(t)
+
+	// dry runs require the signer to be an address
+	out, err := fixture.execMsg(t, "send", fixture.from.String(), fixture.to.String(), "10foo", "--dry-run")
+	assert.NilError(t, err)
+	assert.Equal(t, "", out)
+
+	// the tx is simulated but not signed nor broadcasted
+	assert.Equal(t, 1, len(fixture.simulated))
+	assert.DeepEqual(t, []sdk.Msg{&banktypes.MsgSend{
+		FromAddress: fixture.from.String(),
+		ToAddress:   fixture.to.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+	}}, fixture.simulated[0].GetMsgs())
+
+	// the estimated gas is used by the generated tx
+	out, err = fixture.execMsg(t, "send", "alice", fixture.to.String(), "10foo",
+		"--gas", "auto",
+		"--gas-adjustment", "2",
+		"--generate-only",
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(fixture.simulated))
+	assert.Equal(t, uint64(2*testSimulatedGas), fixture.decodeTx(t, out).(sdk.FeeTx).GetGas())
+}
+
+func TestMsgSendMsg(t *testing.T) {
+	var sent []protoreflect.Message
+	b := &Builder{
+		SendMsg: func(cmd *cobra.Command, msg protoreflect.Message) error {
+			sent = append(sent, msg)
+			return nil
+		},
+	}
+	cmd := topLevelCmd("bank", "Transations commands for the bank module")
+	assert.NilError(t, b.AddMsgServiceCommands(cmd, testBankMsgDesc))
+
+	// the msg is passed as is, without resolving its signer
+	cmd.SetArgs([]string{"send", "alice", "to", "10foo"})
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	assert.NilError(t, cmd.Execute())
+
+	assert.Equal(t, 1, len(sent))
+	msg := sent[0].Interface().(*bankv1beta1.MsgSend)
+	assert.Equal(t, "alice", msg.FromAddress)
+	assert.Equal(t, "to", msg.ToAddress)
+	assert.Equal(t, "10", msg.Amount[0].Amount)
+	assert.Equal(t, "from_address", string(MsgSignerField(sent[0].Descriptor()).Name()))
 }
 
 func TestMsgOptionsError(t *testing.T) {
@@ -140,7 +320,7 @@ func TestMsgOptionsError(t *testing.T) {
 	assert.Assert(t, strings.Contains(conn.errorOut.String(), "requires at least 3 arg"))
 
 	conn = testExecCommon(t, buildModuleMsgCommand,
-		"send", "5", "6", `{"denom":"foo","amount":"1"}`,
+		"send", "5", "6", "1foo",
 		"--uint32", "7",
 		"--u64", "abc",
 	)
@@ -150,71 +330,16 @@ func TestMsgOptionsError(t *testing.T) {
 
 func TestDeprecatedMsg(t *testing.T) {
 	conn := testExecCommon(t, buildModuleMsgCommand, "send",
-		"1", "abc", `{"denom":"foo","amount":"1"}`,
+		"1", "abc", "1foo",
 		"--deprecated-field", "foo")
 	assert.Assert(t, strings.Contains(conn.out.String(), "--deprecated-field has been deprecated"))
 
 	conn = testExecCommon(t, buildModuleMsgCommand, "send",
-		"1", "abc", `{"denom":"foo","amount":"1"}`,
+		"1", "abc", "1foo",
 		"-d", "foo")
 	assert.Assert(t, strings.Contains(conn.out.String(), "--shorthand-deprecated-field has been deprecated"))
 }
 
-func TestEverythingMsg(t *testing.T) {
-	conn := testExecCommon(t, buildModuleMsgCommand,
-		"send",
-		"1",
-		"abc",
-		`{"denom":"foo","amount":"1234"}`,
-		`{"denom":"bar","amount":"4321"}`,
-		"--output", "json",
-		"--a-bool",
-		"--an-enum", "two",
-		"--a-message", `{"bar":"abc", "baz":-3}`,
-		"--duration", "4h3s",
-		"--uint32", "27",
-		"--u64", "3267246890",
-		"--i32", "-253",
-		"--i64", "-234602347",
-		"--str", "def",
-		"--timestamp", "2019-01-02T00:01:02Z",
-		"--a-coin", `{"denom":"foo","amount":"100000"}`,
-		"--an-address", "cosmossdghdsfoi2134sdgh",
-		"--bz", "c2RncXdlZndkZ3NkZw==",
-		"--page-count-total",
-		"--page-key", "MTIzNTQ4N3NnaGRhcw==",
-		"--page-limit", "1000",
-		"--page-offset", "10",
-		"--page-reverse",
-		"--bools", "true",
-		"--bools", "false,false,true",
-		"--enums", "one",
-		"--enums", "five",
-		"--enums", "two",
-		"--strings", "abc",
-		"--strings", "xyz",
-		"--strings", "xyz,qrs",
-		"--durations", "3s",
-		"--durations", "5s",
-		"--durations", "10h",
-		"--some-messages", "{}",
-		"--some-messages", `{"bar":"baz"}`,
-		"--some-messages", `{"baz":-1}`,
-		"--uints", "1,2,3",
-		"--uints", "4",
-	)
-	response := conn.out.String()
-	var output testpb.MsgRequest
-	err := protojson.Unmarshal([]byte(response), &output)
-	assert.NilError(t, err)
-	assert.Equal(t, output.GetU32(), uint32(27))
-	assert.Equal(t, output.GetU64(), uint64(3267246890))
-	assert.Equal(t, output.GetPositional1(), int32(1))
-	assert.Equal(t, output.GetPositional2(), "abc")
-	assert.Equal(t, output.GetABool(), true)
-	assert.Equal(t, output.GetAnEnum(), testpb.Enum_ENUM_TWO)
-}
-
 func TestHelpMsg(t *testing.T) {
 	conn := testExecCommon(t, buildModuleMsgCommand, "-h")
 	golden.Assert(t, conn.out.String(), "help-toplevel-msg.golden")
@@ -374,3 +499,22 @@ func TestEnhanceMessageCommand(t *testing.T) {
 type testMessageServer struct {
 	testpb.UnimplementedMsgServer
 }
+
+const testSimulatedGas = 50000
+
+type testTxServer struct {
+	txtypes.UnimplementedServiceServer
+
+	fixture   *txFixture
+	txDecoder sdk.TxDecoder
+}
+
+func (s *testTxServer) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	tx, err := s.txDecoder(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fixture.simulated = append(s.fixture.simulated, tx)
+	return &txtypes.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: testSimulatedGas}}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -104,8 +105,8 @@ func TestEverything(t *testing.T) {
 		"echo",
 		"1",
 		"abc",
-		`{"denom":"foo","amount":"1234"}`,
-		`{"denom":"bar","amount":"4321"}`,
+		"1234foo",
+		"4321bar",
 		"--a-bool",
 		"--an-enum", "one",
 		"--a-message", `{"bar":"abc", "baz":-3}`,
@@ -116,7 +117,7 @@ func TestEverything(t *testing.T) {
 		"--i64", "-234602347",
 		"--str", "def",
 		"--timestamp", "2019-01-02T00:01:02Z",
-		"--a-coin", `{"denom":"foo","amount":"100000"}`,
+		"--a-coin", "100000foo",
 		"--an-address", "cosmossdghdsfoi2134sdgh",
 		"--bz", "c2RncXdlZndkZ3NkZw==",
 		"--page-count-total",
@@ -144,10 +145,60 @@ func TestEverything(t *testing.T) {
 	assert.DeepEqual(t, conn.lastRequest, conn.lastResponse.(*testpb.EchoResponse).Request, protocmp.Transform())
 }
 
+func TestCoinAndDecFlags(t *testing.T) {
+	conn := testExecCommon(t, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "5stake,10foo", "1bar",
+		"--a-coin", "100foo",
+		"--a-dec-coin", "1.5foo",
+		"--dec-coins", "0.5stake,2bar",
+		"--a-dec", "0.05",
+	)
+	assert.Equal(t, "", conn.errorOut.String())
+
+	request := conn.lastRequest.(*testpb.EchoRequest)
+	assert.DeepEqual(t, []*basev1beta1.Coin{
+		{Denom: "bar", Amount: "1"},
+		{Denom: "foo", Amount: "10"},
+		{Denom: "stake", Amount: "5"},
+	}, request.Positional3Varargs, protocmp.Transform())
+	assert.DeepEqual(t, &basev1beta1.Coin{Denom: "foo", Amount: "100"}, request.ACoin, protocmp.Transform())
+
+	// the amounts of the dec coins are LegacyDec custom types, encoded as integers scaled by 10^18
+	assert.DeepEqual(t, &basev1beta1.DecCoin{Denom: "foo", Amount: "1500000000000000000"}, request.ADecCoin, protocmp.Transform())
+	assert.DeepEqual(t, []*basev1beta1.DecCoin{
+		{Denom: "bar", Amount: "2000000000000000000"},
+		{Denom: "stake", Amount: "500000000000000000"},
+	}, request.DecCoins, protocmp.Transform())
+	assert.Equal(t, "0.050000000000000000", request.ADec)
+}
+
+func TestCoinAndDecFlagsError(t *testing.T) {
+	conn := testExecCommon(t, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1.5foo",
+	)
+	assert.Assert(t, strings.Contains(conn.errorOut.String(), `invalid argument "1.5foo" for positional3_varargs: coin amount must be an integer`))
+
+	conn = testExecCommon(t, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--a-coin", "1foo,2bar",
+	)
+	assert.Assert(t, strings.Contains(conn.errorOut.String(), `invalid argument "1foo,2bar" for "--a-coin" flag: expected a single coin`))
+
+	conn = testExecCommon(t, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--a-dec", "abc",
+	)
+	assert.Assert(t, strings.Contains(conn.errorOut.String(), `invalid argument "abc" for "--a-dec" flag`))
+}
+
 func TestOptions(t *testing.T) {
 	conn := testExecCommon(t, buildModuleQueryCommand,
 		"echo",
-		"1", "abc", `{"denom":"foo","amount":"1"}`,
+		"1", "abc", "1foo",
 		"-u", "27", // shorthand
 		"--u64", "5", // no opt default value
 	)
@@ -160,13 +211,13 @@ func TestOptions(t *testing.T) {
 func TestOutputFormat(t *testing.T) {
 	conn := testExecCommon(t, buildModuleQueryCommand,
 		"echo",
-		"1", "abc", `{"denom":"foo","amount":"1"}`,
+		"1", "abc", "1foo",
 		"--output", "json",
 	)
 	assert.Assert(t, strings.Contains(conn.out.String(), "{"))
 	conn = testExecCommon(t, buildModuleQueryCommand,
 		"echo",
-		"1", "abc", `{"denom":"foo","amount":"1"}`,
+		"1", "abc", "1foo",
 		"--output", "text",
 	)
 	fmt.Println(conn.out.String())
@@ -48,4 +48,20 @@ func TestKeyCorrectness(t *testing.T) {
 			collections.Join("hello", "testing"),
 		)
 	})
+
+	t.Run("Triple", func(t *testing.T) {
+		colltest.TestKeyCodec(
+			t,
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.StringKey),
+			collections.Join3("hello", uint64(10), "testing"),
+		)
+	})
+
+	t.Run("Quad", func(t *testing.T) {
+		colltest.TestKeyCodec(
+			t,
+			collections.QuadKeyCodec(collections.StringKey, collections.Uint64Key, collections.BoolKey, collections.StringKey),
+			collections.Join4("hello", uint64(10), true, "testing"),
+		)
+	})
 }
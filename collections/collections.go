@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"context"
 	"errors"
 	"math"
 
@@ -72,16 +73,40 @@ var (
 	BytesValue = codec.KeyToValueCodec(BytesKey)
 )
 
+// Collection is the interface that all collections support. It allows to inspect
+// and read the collections of a Schema without knowing the types of their keys
+// and values, through the JSON representation of their keys and values.
+type Collection interface {
+	// GetName is the unique name of the collection within a schema. It must
+	// match format specified by NameRegex.
+	GetName() string
+
+	// GetPrefix is the unique prefix of the collection within a schema.
+	GetPrefix() []byte
+
+	// KeyType returns the name of the type of the keys of the collection.
+	KeyType() string
+
+	// ValueType returns the name of the type of the values of the collection.
+	ValueType() string
+
+	// GetJSON returns the JSON encoded value associated with the provided JSON
+	// encoded key. Errors with ErrNotFound if the key does not exist.
+	GetJSON(ctx context.Context, key []byte) ([]byte, error)
+
+	// WalkJSON iterates over the collection between the provided raw start and
+	// end keys, which do not include the collection prefix, and calls walkFunc
+	// with the raw key and the JSON encoded key and value of every entry.
+	// A nil start and a nil end iterate over the entire collection.
+	// If walkFunc returns true the walking is stopped.
+	WalkJSON(ctx context.Context, start, end []byte, order Order, walkFunc func(rawKey, key, value []byte) (stop bool)) error
+}
+
 // collection is the interface that all collections support. It will eventually
 // include methods for importing/exporting genesis data and schema
 // reflection for clients.
 type collection interface {
-	// getName is the unique name of the collection within a schema. It must
-	// match format specified by NameRegex.
-	getName() string
-
-	// getPrefix is the unique prefix of the collection within a schema.
-	getPrefix() []byte
+	Collection
 
 	genesisHandler
 }
@@ -29,6 +29,14 @@ type Index[PrimaryKey, Value any] interface {
 	Unreference(ctx context.Context, pk PrimaryKey, value Value) error
 }
 
+// ResettableIndex represents an Index whose references can all be removed, which
+// allows to rebuild it from the objects saved in an IndexedMap using IndexedMap.Reindex.
+type ResettableIndex[PrimaryKey, Value any] interface {
+	Index[PrimaryKey, Value]
+	// Reset removes all the references of the Index.
+	Reset(ctx context.Context) error
+}
+
 // IndexedMap works like a Map but creates references between fields of Value and its PrimaryKey.
 // These relationships are expressed and maintained using the Indexes type.
 // Internally IndexedMap can be seen as a partitioned collection, one partition
@@ -116,6 +124,45 @@ func (m *IndexedMap[PrimaryKey, Value, Idx]) Remove(ctx context.Context, pk Prim
 	return m.m.Remove(ctx, pk)
 }
 
+// Reindex rebuilds all the indexes from the objects saved in the IndexedMap.
+// Every index is reset first, so all of them must implement ResettableIndex.
+// It is meant to be used in migrations, when a new index is added to an
+// IndexedMap which already contains objects.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) Reindex(ctx context.Context) error {
+	for _, index := range m.Indexes.IndexesList() {
+		resettable, ok := index.(ResettableIndex[PrimaryKey, Value])
+		if !ok {
+			return fmt.Errorf("collections: index %T cannot be reset", index)
+		}
+		err := resettable.Reset(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	iter, err := m.m.Iterate(ctx, nil)
+	if err != nil {
+		// nothing to index
+		if errors.Is(err, ErrInvalidIterator) {
+			return nil
+		}
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		err = m.ref(ctx, kv.Key, kv.Value, nil)
+		if err != nil {
+			return fmt.Errorf("collections: indexing error: %w", err)
+		}
+	}
+	return nil
+}
+
 // Walk applies the same semantics as Map.Walk.
 func (m *IndexedMap[PrimaryKey, Value, Idx]) Walk(ctx context.Context, ranger Ranger[PrimaryKey], walkFunc func(key PrimaryKey, value Value) bool) error {
 	return m.m.Walk(ctx, ranger, walkFunc)
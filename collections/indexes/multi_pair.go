@@ -71,6 +71,11 @@ func (i *MultiPair[K1, K2, Value]) Unreference(ctx context.Context, pk collectio
 	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).Unreference(ctx, pk, value)
 }
 
+// Reset implements collections.ResettableIndex
+func (i *MultiPair[K1, K2, Value]) Reset(ctx context.Context) error {
+	return (*collections.GenericMultiIndex[K2, K1, collections.Pair[K1, K2], Value])(i).Reset(ctx)
+}
+
 func (i *MultiPair[K1, K2, Value]) Walk(
 	ctx context.Context,
 	ranger collections.Ranger[collections.Pair[K2, K1]],
@@ -0,0 +1,66 @@
+package indexes
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	Delegator = string
+	Validator = string
+	Height    = uint64
+)
+
+// our unbonding index, allows us to efficiently create an index between the key that maps
+// unbonding entries which is a collections.Triple[Delegator, Validator, Height] and the Validator.
+type unbondingIndex struct {
+	Validator *MultiTriple[Delegator, Validator, Height, Amount]
+}
+
+func (u unbondingIndex) IndexesList() []collections.Index[collections.Triple[Delegator, Validator, Height], Amount] {
+	return []collections.Index[collections.Triple[Delegator, Validator, Height], Amount]{u.Validator}
+}
+
+func TestMultiTriple(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("unbondings"), "unbondings",
+		keyCodec,
+		collections.Uint64Value,
+		unbondingIndex{
+			Validator: NewMultiTriple[Amount](sb, collections.NewPrefix("validator_index"), "validator_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, collections.Join3("delegator1", "validator1", uint64(10)), 100))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join3("delegator1", "validator2", uint64(11)), 200))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join3("delegator2", "validator2", uint64(12)), 300))
+
+	// assert if we iterate over validator2 we find the entries of delegator1 and delegator2
+	iter, err := indexedMap.Indexes.Validator.MatchExact(ctx, "validator2")
+	require.NoError(t, err)
+
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Delegator, Validator, Height]{
+		collections.Join3("delegator1", "validator2", uint64(11)),
+		collections.Join3("delegator2", "validator2", uint64(12)),
+	}, pks)
+
+	// assert removal is reflected in the index
+	require.NoError(t, indexedMap.Remove(ctx, collections.Join3("delegator1", "validator2", uint64(11))))
+	iter, err = indexedMap.Indexes.Validator.MatchExact(ctx, "validator2")
+	require.NoError(t, err)
+
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Delegator, Validator, Height]{
+		collections.Join3("delegator2", "validator2", uint64(12)),
+	}, pks)
+}
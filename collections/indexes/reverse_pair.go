@@ -0,0 +1,103 @@
+package indexes
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// ReversePair is an index that is used with collections.Pair keys. It indexes objects by their second part of the key,
+// saving only the reversed primary key: Pair[K2, K1].
+// Compared to MultiPair, the index entry of an object only depends on its primary key and never on its value,
+// so the value is never inspected and the index is not rewritten when the value of an existing object is updated.
+type ReversePair[K1, K2, Value any] struct {
+	refKeys collections.KeySet[collections.Pair[K2, K1]]
+}
+
+// NewReversePair instantiates a new ReversePair index.
+// NOTE: when using this function you will need to type hint: doing NewReversePair[Value]()
+// Example: if the value of the indexed map is string, you need to do NewReversePair[string](...)
+func NewReversePair[Value any, K1, K2 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	pairCodec codec.KeyCodec[collections.Pair[K1, K2]],
+) *ReversePair[K1, K2, Value] {
+	pkc := pairCodec.(pairKeyCodec[K1, K2])
+	return &ReversePair[K1, K2, Value]{
+		refKeys: collections.NewKeySet(
+			sb,
+			prefix,
+			name,
+			collections.PairKeyCodec(pkc.KeyCodec2(), pkc.KeyCodec1()),
+		),
+	}
+}
+
+// Iterate exposes the raw iterator API.
+func (i *ReversePair[K1, K2, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[K2, K1]]) (iter MultiPairIterator[K2, K1], err error) {
+	sIter, err := i.refKeys.Iterate(ctx, ranger)
+	if err != nil {
+		return iter, err
+	}
+	return (MultiPairIterator[K2, K1])(sIter), nil
+}
+
+// MatchExact will return an iterator containing only the primary keys starting with the provided second part of the multipart pair key.
+func (i *ReversePair[K1, K2, Value]) MatchExact(ctx context.Context, key K2) (MultiPairIterator[K2, K1], error) {
+	return i.Iterate(ctx, collections.NewPrefixedPairRange[K2, K1](key))
+}
+
+// Reference implements collections.Index
+func (i *ReversePair[K1, K2, Value]) Reference(ctx context.Context, pk collections.Pair[K1, K2], _ Value, oldValue *Value) error {
+	// the object is already referenced, and its reference does not depend on the value
+	if oldValue != nil {
+		return nil
+	}
+	return i.refKeys.Set(ctx, collections.Join(pk.K2(), pk.K1()))
+}
+
+// Unreference implements collections.Index
+func (i *ReversePair[K1, K2, Value]) Unreference(ctx context.Context, pk collections.Pair[K1, K2], _ Value) error {
+	return i.refKeys.Remove(ctx, collections.Join(pk.K2(), pk.K1()))
+}
+
+// Reset implements collections.ResettableIndex
+func (i *ReversePair[K1, K2, Value]) Reset(ctx context.Context) error {
+	var keys []collections.Pair[K2, K1]
+	err := i.refKeys.Walk(ctx, nil, func(key collections.Pair[K2, K1]) bool {
+		keys = append(keys, key)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, key := range keys {
+		err = i.refKeys.Remove(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *ReversePair[K1, K2, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Pair[K2, K1]],
+	walkFunc func(indexingKey K2, indexedKey K1) bool,
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Pair[K2, K1]) bool {
+		return walkFunc(key.K1(), key.K2())
+	})
+}
+
+func (i *ReversePair[K1, K2, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Pair[K2, K1], collections.NoValue], err error,
+) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
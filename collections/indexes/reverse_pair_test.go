@@ -0,0 +1,144 @@
+package indexes
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+)
+
+type reverseBalanceIndex struct {
+	Denom *ReversePair[Address, Denom, Amount]
+}
+
+func (b reverseBalanceIndex) IndexesList() []collections.Index[collections.Pair[Address, Denom], Amount] {
+	return []collections.Index[collections.Pair[Address, Denom], Amount]{b.Denom}
+}
+
+func TestReversePair(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		reverseBalanceIndex{
+			Denom: NewReversePair[Amount](sb, collections.NewPrefix("denom_index"), "denom_index", keyCodec),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "atom"), 100))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address1", "osmo"), 200))
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "osmo"), 300))
+	// updating the value leaves the index untouched
+	require.NoError(t, indexedMap.Set(ctx, collections.Join("address2", "osmo"), 400))
+
+	iter, err := indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Address, Denom]{
+		collections.Join("address1", "osmo"),
+		collections.Join("address2", "osmo"),
+	}, pks)
+
+	require.NoError(t, indexedMap.Remove(ctx, collections.Join("address1", "osmo")))
+	iter, err = indexedMap.Indexes.Denom.MatchExact(ctx, "osmo")
+	require.NoError(t, err)
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Address, Denom]{collections.Join("address2", "osmo")}, pks)
+}
+
+func TestReindex(t *testing.T) {
+	sk, ctx := deps()
+
+	// the objects are saved before the index exists
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	balances := collections.NewMap(sb, collections.NewPrefix("balances"), "balances", keyCodec, collections.Uint64Value)
+	require.NoError(t, balances.Set(ctx, collections.Join("address1", "atom"), 100))
+	require.NoError(t, balances.Set(ctx, collections.Join("address2", "atom"), 200))
+	require.NoError(t, balances.Set(ctx, collections.Join("address2", "osmo"), 300))
+
+	sb = collections.NewSchemaBuilder(sk)
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		balanceIndexes{
+			Denom:  NewReversePair[Amount](sb, collections.NewPrefix("denom_index"), "denom_index", keyCodec),
+			Amount: NewUnique(sb, collections.NewPrefix("amount_index"), "amount_index", collections.Uint64Key, keyCodec, getAmount),
+		},
+	)
+
+	// stale references are removed
+	require.NoError(t, indexedMap.Indexes.Denom.Reference(ctx, collections.Join("address3", "atom"), 0, nil))
+
+	require.NoError(t, indexedMap.Reindex(ctx))
+	// reindexing is idempotent
+	require.NoError(t, indexedMap.Reindex(ctx))
+
+	iter, err := indexedMap.Indexes.Denom.MatchExact(ctx, "atom")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Address, Denom]{
+		collections.Join("address1", "atom"),
+		collections.Join("address2", "atom"),
+	}, pks)
+
+	pk, err := indexedMap.Indexes.Amount.MatchExact(ctx, 300)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join("address2", "osmo"), pk)
+}
+
+func TestReindex_NotResettable(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		notResettableIndexes{},
+	)
+
+	require.ErrorContains(t, indexedMap.Reindex(ctx), "cannot be reset")
+}
+
+type balanceIndexes struct {
+	Denom  *ReversePair[Address, Denom, Amount]
+	Amount *Unique[Amount, collections.Pair[Address, Denom], Amount]
+}
+
+func (i balanceIndexes) IndexesList() []collections.Index[collections.Pair[Address, Denom], Amount] {
+	return []collections.Index[collections.Pair[Address, Denom], Amount]{i.Denom, i.Amount}
+}
+
+func getAmount(_ collections.Pair[Address, Denom], amount Amount) (Amount, error) {
+	return amount, nil
+}
+
+type notResettableIndexes struct{}
+
+func (notResettableIndexes) IndexesList() []collections.Index[collections.Pair[Address, Denom], Amount] {
+	return []collections.Index[collections.Pair[Address, Denom], Amount]{notResettableIndex{}}
+}
+
+type notResettableIndex struct{}
+
+func (notResettableIndex) Reference(_ context.Context, _ collections.Pair[Address, Denom], _ Amount, _ *Amount) error {
+	return nil
+}
+
+func (notResettableIndex) Unreference(_ context.Context, _ collections.Pair[Address, Denom], _ Amount) error {
+	return nil
+}
@@ -42,6 +42,11 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Contex
 	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).Unreference(ctx, pk, value)
 }
 
+// Reset implements collections.ResettableIndex
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) Reset(ctx context.Context) error {
+	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).Reset(ctx)
+}
+
 func (i *Unique[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, ref ReferenceKey) (PrimaryKey, error) {
 	return (*collections.GenericUniqueIndex[ReferenceKey, PrimaryKey, PrimaryKey, Value])(i).Get(ctx, ref)
 }
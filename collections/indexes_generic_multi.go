@@ -132,6 +132,11 @@ func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) Un
 	return nil
 }
 
+// Reset removes all the references of the index.
+func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) Reset(ctx context.Context) error {
+	return (Map[Pair[ReferencingKey, ReferencedKey], NoValue])(i.refs).clear(ctx)
+}
+
 func (i *GenericMultiIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) IterateRaw(
 	ctx context.Context,
 	start, end []byte,
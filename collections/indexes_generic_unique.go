@@ -105,6 +105,11 @@ func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) U
 	return nil
 }
 
+// Reset removes all the references of the index.
+func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) Reset(ctx context.Context) error {
+	return i.refs.clear(ctx)
+}
+
 func (i *GenericUniqueIndex[ReferencingKey, ReferencedKey, PrimaryKey, Value]) IterateRaw(
 	ctx context.Context,
 	start, end []byte,
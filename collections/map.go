@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections/codec"
@@ -43,11 +44,11 @@ func NewMap[K, V any](
 	return m
 }
 
-func (m Map[K, V]) getName() string {
+func (m Map[K, V]) GetName() string {
 	return m.name
 }
 
-func (m Map[K, V]) getPrefix() []byte {
+func (m Map[K, V]) GetPrefix() []byte {
 	return m.prefix
 }
 
@@ -189,6 +190,86 @@ func (m Map[K, V]) IterateRaw(ctx context.Context, start, end []byte, order Orde
 	}, nil
 }
 
+// clear removes all the keys contained in the Map.
+func (m Map[K, V]) clear(ctx context.Context) error {
+	kvStore := m.sa(ctx)
+	iter, err := kvStore.Iterator(m.prefix, nextBytesPrefixKey(m.prefix))
+	if err != nil {
+		return err
+	}
+
+	// collect the keys first, as the store must not be written while iterating
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	err = iter.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err = kvStore.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KeyType implements Collection.
+func (m Map[K, V]) KeyType() string { return m.kc.KeyType() }
+
+// ValueType implements Collection.
+func (m Map[K, V]) ValueType() string { return m.vc.ValueType() }
+
+// GetJSON implements Collection.
+func (m Map[K, V]) GetJSON(ctx context.Context, key []byte) ([]byte, error) {
+	k, err := m.kc.DecodeJSON(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key decode: %s", ErrEncoding, err)
+	}
+	v, err := m.Get(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+	return m.vc.EncodeJSON(v)
+}
+
+// WalkJSON implements Collection.
+func (m Map[K, V]) WalkJSON(
+	ctx context.Context, start, end []byte, order Order, walkFunc func(rawKey, key, value []byte) (stop bool),
+) error {
+	iter, err := m.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		// empty range
+		if errors.Is(err, ErrInvalidIterator) {
+			return nil
+		}
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		key, err := m.kc.EncodeJSON(kv.Key)
+		if err != nil {
+			return err
+		}
+		value, err := m.vc.EncodeJSON(kv.Value)
+		if err != nil {
+			return err
+		}
+		if walkFunc(iter.iter.Key()[iter.prefixLength:], key, value) {
+			return nil
+		}
+	}
+	return nil
+}
+
 // KeyCodec returns the Map's KeyCodec.
 func (m Map[K, V]) KeyCodec() codec.KeyCodec[K] { return m.kc }
 
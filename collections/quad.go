@@ -0,0 +1,346 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// Quad defines a multipart key composed of four keys.
+type Quad[K1, K2, K3, K4 any] struct {
+	key1 *K1
+	key2 *K2
+	key3 *K3
+	key4 *K4
+}
+
+// K1 returns the first part of the key.
+// If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K1() (k1 K1) {
+	if q.key1 == nil {
+		return
+	}
+	return *q.key1
+}
+
+// K2 returns the second part of the key.
+// If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K2() (k2 K2) {
+	if q.key2 == nil {
+		return
+	}
+	return *q.key2
+}
+
+// K3 returns the third part of the key.
+// If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K3() (k3 K3) {
+	if q.key3 == nil {
+		return
+	}
+	return *q.key3
+}
+
+// K4 returns the fourth part of the key.
+// If not present the zero value is returned.
+func (q Quad[K1, K2, K3, K4]) K4() (k4 K4) {
+	if q.key4 == nil {
+		return
+	}
+	return *q.key4
+}
+
+// Join4 creates a new Quad instance composed of the four provided keys, in order.
+func Join4[K1, K2, K3, K4 any](key1 K1, key2 K2, key3 K3, key4 K4) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{
+		key1: &key1,
+		key2: &key2,
+		key3: &key3,
+		key4: &key4,
+	}
+}
+
+// QuadPrefix creates a new Quad instance composed only of the first part of the key.
+func QuadPrefix[K1, K2, K3, K4 any](key1 K1) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{key1: &key1}
+}
+
+// QuadSuperPrefix creates a new Quad instance composed only of the first two parts of the key.
+func QuadSuperPrefix[K1, K2, K3, K4 any](key1 K1, key2 K2) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{key1: &key1, key2: &key2}
+}
+
+// QuadSuperPrefix3 creates a new Quad instance composed only of the first three parts of the key.
+func QuadSuperPrefix3[K1, K2, K3, K4 any](key1 K1, key2 K2, key3 K3) Quad[K1, K2, K3, K4] {
+	return Quad[K1, K2, K3, K4]{key1: &key1, key2: &key2, key3: &key3}
+}
+
+// QuadKeyCodec instantiates a new KeyCodec instance that can encode the Quad, given
+// the KeyCodecs of the four parts of the key, in order.
+func QuadKeyCodec[K1, K2, K3, K4 any](
+	keyCodec1 codec.KeyCodec[K1],
+	keyCodec2 codec.KeyCodec[K2],
+	keyCodec3 codec.KeyCodec[K3],
+	keyCodec4 codec.KeyCodec[K4],
+) codec.KeyCodec[Quad[K1, K2, K3, K4]] {
+	return quadKeyCodec[K1, K2, K3, K4]{
+		keyCodec1: keyCodec1,
+		keyCodec2: keyCodec2,
+		keyCodec3: keyCodec3,
+		keyCodec4: keyCodec4,
+	}
+}
+
+type quadKeyCodec[K1, K2, K3, K4 any] struct {
+	keyCodec1 codec.KeyCodec[K1]
+	keyCodec2 codec.KeyCodec[K2]
+	keyCodec3 codec.KeyCodec[K3]
+	keyCodec4 codec.KeyCodec[K4]
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec1() codec.KeyCodec[K1] { return q.keyCodec1 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec2() codec.KeyCodec[K2] { return q.keyCodec2 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec3() codec.KeyCodec[K3] { return q.keyCodec3 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyCodec4() codec.KeyCodec[K4] { return q.keyCodec4 }
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Encode(buffer []byte, key Quad[K1, K2, K3, K4]) (int, error) {
+	writtenTotal, err := q.encodeFirstThree(buffer, key)
+	if err != nil {
+		return 0, err
+	}
+	if key.key4 != nil {
+		written, err := q.keyCodec4.Encode(buffer[writtenTotal:], *key.key4)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Decode(buffer []byte) (int, Quad[K1, K2, K3, K4], error) {
+	readTotal, key1, key2, key3, err := q.decodeFirstThree(buffer)
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	read, key4, err := q.keyCodec4.Decode(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	return readTotal, Join4(key1, key2, key3, key4), nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Size(key Quad[K1, K2, K3, K4]) int {
+	size := q.sizeFirstThree(key)
+	if key.key4 != nil {
+		size += q.keyCodec4.Size(*key.key4)
+	}
+	return size
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) EncodeNonTerminal(buffer []byte, key Quad[K1, K2, K3, K4]) (int, error) {
+	writtenTotal, err := q.encodeFirstThree(buffer, key)
+	if err != nil {
+		return 0, err
+	}
+	if key.key4 != nil {
+		written, err := q.keyCodec4.EncodeNonTerminal(buffer[writtenTotal:], *key.key4)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) DecodeNonTerminal(buffer []byte) (int, Quad[K1, K2, K3, K4], error) {
+	readTotal, key1, key2, key3, err := q.decodeFirstThree(buffer)
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	read, key4, err := q.keyCodec4.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Quad[K1, K2, K3, K4]{}, err
+	}
+	readTotal += read
+	return readTotal, Join4(key1, key2, key3, key4), nil
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) SizeNonTerminal(key Quad[K1, K2, K3, K4]) int {
+	size := q.sizeFirstThree(key)
+	if key.key4 != nil {
+		size += q.keyCodec4.SizeNonTerminal(*key.key4)
+	}
+	return size
+}
+
+// encodeFirstThree encodes the first three parts of the key, which are always
+// encoded as non-terminal.
+func (q quadKeyCodec[K1, K2, K3, K4]) encodeFirstThree(buffer []byte, key Quad[K1, K2, K3, K4]) (int, error) {
+	writtenTotal := 0
+	if key.key1 != nil {
+		written, err := q.keyCodec1.EncodeNonTerminal(buffer, *key.key1)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.key2 != nil {
+		written, err := q.keyCodec2.EncodeNonTerminal(buffer[writtenTotal:], *key.key2)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.key3 != nil {
+		written, err := q.keyCodec3.EncodeNonTerminal(buffer[writtenTotal:], *key.key3)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+// decodeFirstThree decodes the first three parts of the key, which are always
+// encoded as non-terminal.
+func (q quadKeyCodec[K1, K2, K3, K4]) decodeFirstThree(buffer []byte) (readTotal int, key1 K1, key2 K2, key3 K3, err error) {
+	read, key1, err := q.keyCodec1.DecodeNonTerminal(buffer)
+	if err != nil {
+		return
+	}
+	readTotal += read
+	read, key2, err = q.keyCodec2.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return
+	}
+	readTotal += read
+	read, key3, err = q.keyCodec3.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return
+	}
+	readTotal += read
+	return
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) sizeFirstThree(key Quad[K1, K2, K3, K4]) int {
+	size := 0
+	if key.key1 != nil {
+		size += q.keyCodec1.SizeNonTerminal(*key.key1)
+	}
+	if key.key2 != nil {
+		size += q.keyCodec2.SizeNonTerminal(*key.key2)
+	}
+	if key.key3 != nil {
+		size += q.keyCodec3.SizeNonTerminal(*key.key3)
+	}
+	return size
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) Stringify(key Quad[K1, K2, K3, K4]) string {
+	b := new(strings.Builder)
+	b.WriteByte('(')
+	writeKeyPart(b, key.key1, q.keyCodec1)
+	b.WriteString(", ")
+	writeKeyPart(b, key.key2, q.keyCodec2)
+	b.WriteString(", ")
+	writeKeyPart(b, key.key3, q.keyCodec3)
+	b.WriteString(", ")
+	writeKeyPart(b, key.key4, q.keyCodec4)
+	b.WriteByte(')')
+	return b.String()
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) KeyType() string {
+	return fmt.Sprintf(
+		"Quad[%s, %s, %s, %s]",
+		q.keyCodec1.KeyType(), q.keyCodec2.KeyType(), q.keyCodec3.KeyType(), q.keyCodec4.KeyType(),
+	)
+}
+
+// GENESIS
+
+type jsonQuadKey [4]json.RawMessage
+
+func (q quadKeyCodec[K1, K2, K3, K4]) EncodeJSON(v Quad[K1, K2, K3, K4]) ([]byte, error) {
+	k1JSON, err := q.keyCodec1.EncodeJSON(v.K1())
+	if err != nil {
+		return nil, err
+	}
+	k2JSON, err := q.keyCodec2.EncodeJSON(v.K2())
+	if err != nil {
+		return nil, err
+	}
+	k3JSON, err := q.keyCodec3.EncodeJSON(v.K3())
+	if err != nil {
+		return nil, err
+	}
+	k4JSON, err := q.keyCodec4.EncodeJSON(v.K4())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonQuadKey{k1JSON, k2JSON, k3JSON, k4JSON})
+}
+
+func (q quadKeyCodec[K1, K2, K3, K4]) DecodeJSON(b []byte) (Quad[K1, K2, K3, K4], error) {
+	quadJSON := jsonQuadKey{}
+	err := json.Unmarshal(b, &quadJSON)
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+
+	k1, err := q.keyCodec1.DecodeJSON(quadJSON[0])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+	k2, err := q.keyCodec2.DecodeJSON(quadJSON[1])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+	k3, err := q.keyCodec3.DecodeJSON(quadJSON[2])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+	k4, err := q.keyCodec4.DecodeJSON(quadJSON[3])
+	if err != nil {
+		return Quad[K1, K2, K3, K4]{}, err
+	}
+
+	return Join4(k1, k2, k3, k4), nil
+}
+
+// NewPrefixedQuadRange creates a new Range which will prefix over all the keys
+// starting with the provided first part of the key.
+func NewPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1) *Range[Quad[K1, K2, K3, K4]] {
+	key := QuadPrefix[K1, K2, K3, K4](k1)
+	return &Range[Quad[K1, K2, K3, K4]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
+
+// NewSuperPrefixedQuadRange creates a new Range which will prefix over all the keys
+// starting with the provided first and second parts of the key.
+func NewSuperPrefixedQuadRange[K1, K2, K3, K4 any](k1 K1, k2 K2) *Range[Quad[K1, K2, K3, K4]] {
+	key := QuadSuperPrefix[K1, K2, K3, K4](k1, k2)
+	return &Range[Quad[K1, K2, K3, K4]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
+
+// NewSuperPrefixedQuadRange3 creates a new Range which will prefix over all the keys
+// starting with the provided first, second and third parts of the key.
+func NewSuperPrefixedQuadRange3[K1, K2, K3, K4 any](k1 K1, k2 K2, k3 K3) *Range[Quad[K1, K2, K3, K4]] {
+	key := QuadSuperPrefix3[K1, K2, K3, K4](k1, k2, k3)
+	return &Range[Quad[K1, K2, K3, K4]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
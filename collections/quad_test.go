@@ -0,0 +1,63 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuad(t *testing.T) {
+	keyCodec := QuadKeyCodec(StringKey, StringKey, StringKey, StringKey)
+	t.Run("stringify", func(t *testing.T) {
+		s := keyCodec.Stringify(Join4("a", "b", "c", "d"))
+		require.Equal(t, `("a", "b", "c", "d")`, s)
+		s = keyCodec.Stringify(QuadSuperPrefix3[string, string, string, string]("a", "b", "c"))
+		require.Equal(t, `("a", "b", "c", <nil>)`, s)
+		s = keyCodec.Stringify(QuadPrefix[string, string, string, string]("a"))
+		require.Equal(t, `("a", <nil>, <nil>, <nil>)`, s)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := keyCodec.EncodeJSON(Join4("k1", "k2", "k3", "k4"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(`["k1","k2","k3","k4"]`), b)
+
+		key, err := keyCodec.DecodeJSON(b)
+		require.NoError(t, err)
+		require.Equal(t, Join4("k1", "k2", "k3", "k4"), key)
+	})
+}
+
+func TestQuadRange(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	kc := QuadKeyCodec(StringKey, StringKey, StringKey, Uint64Key)
+	m := NewMap(schema, NewPrefix(0), "quad", kc, Uint64Value)
+
+	require.NoError(t, m.Set(ctx, Join4("A", "X", "1", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join4("A", "X", "1", uint64(1)), 0))
+	require.NoError(t, m.Set(ctx, Join4("A", "X", "2", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join4("A", "Y", "1", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join4("B", "X", "1", uint64(0)), 0))
+
+	iter, err := m.Iterate(ctx, NewPrefixedQuadRange[string, string, string, uint64]("A"))
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 4)
+
+	iter, err = m.Iterate(ctx, NewSuperPrefixedQuadRange[string, string, string, uint64]("A", "X"))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	iter, err = m.Iterate(ctx, NewSuperPrefixedQuadRange3[string, string, string, uint64]("A", "X", "1"))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Quad[string, string, string, uint64]{
+		Join4("A", "X", "1", uint64(0)),
+		Join4("A", "X", "1", uint64(1)),
+	}, keys)
+}
@@ -85,8 +85,8 @@ func (s *SchemaBuilder) Build() (Schema, error) {
 }
 
 func (s *SchemaBuilder) addCollection(collection collection) {
-	prefix := collection.getPrefix()
-	name := collection.getName()
+	prefix := collection.GetPrefix()
+	name := collection.GetName()
 
 	if _, ok := s.schema.collectionsByPrefix[string(prefix)]; ok {
 		s.appendError(fmt.Errorf("prefix %v already taken within schema", prefix))
@@ -279,6 +279,20 @@ func (s Schema) exportGenesis(ctx context.Context, target appmodule.GenesisTarge
 	return coll.exportGenesis(ctx, wc)
 }
 
+// ListCollections returns all the collections of the Schema, ordered by name.
+func (s Schema) ListCollections() []Collection {
+	colls := make([]Collection, 0, len(s.collectionsOrdered))
+	for _, name := range s.collectionsOrdered {
+		colls = append(colls, s.collectionsByName[name])
+	}
+	return colls
+}
+
+// GetCollection returns the collection of the Schema with the provided name.
+func (s Schema) GetCollection(name string) (Collection, error) {
+	return s.getCollection(name)
+}
+
 func (s Schema) getCollection(name string) (collection, error) {
 	coll, ok := s.collectionsByName[name]
 	if !ok {
@@ -70,3 +70,68 @@ func TestSchemaBuilderCantBeUsedAfterBuild(t *testing.T) {
 		NewMap(schemaBuilder, NewPrefix(2), "def", Uint64Key, Uint64Value)
 	})
 }
+
+func TestSchemaCollections(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	item := NewItem(schemaBuilder, NewPrefix(2), "params", StringValue)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	colls := schema.ListCollections()
+	require.Len(t, colls, 2)
+	require.Equal(t, "balances", colls[0].GetName())
+	require.Equal(t, []byte{1}, colls[0].GetPrefix())
+	require.Equal(t, "Pair[string, string]", colls[0].KeyType())
+	require.Equal(t, "uint64", colls[0].ValueType())
+	require.Equal(t, "params", colls[1].GetName())
+
+	require.NoError(t, m.Set(ctx, Join("alice", "atom"), 1))
+	require.NoError(t, m.Set(ctx, Join("alice", "osmo"), 2))
+	require.NoError(t, m.Set(ctx, Join("bob", "atom"), 3))
+	require.NoError(t, item.Set(ctx, "hello"))
+
+	coll, err := schema.GetCollection("balances")
+	require.NoError(t, err)
+
+	value, err := coll.GetJSON(ctx, []byte(`["alice","osmo"]`))
+	require.NoError(t, err)
+	require.Equal(t, `"2"`, string(value))
+
+	_, err = coll.GetJSON(ctx, []byte(`["bob","osmo"]`))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = coll.GetJSON(ctx, []byte(`"alice"`))
+	require.ErrorIs(t, err, ErrEncoding)
+
+	var keys, values []string
+	var rawKeys [][]byte
+	err = coll.WalkJSON(ctx, nil, nil, OrderAscending, func(rawKey, key, value []byte) bool {
+		rawKeys = append(rawKeys, append([]byte{}, rawKey...))
+		keys = append(keys, string(key))
+		values = append(values, string(value))
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["alice","atom"]`, `["alice","osmo"]`, `["bob","atom"]`}, keys)
+	require.Equal(t, []string{`"1"`, `"2"`, `"3"`}, values)
+
+	// walking from a raw key resumes the iteration
+	keys = nil
+	err = coll.WalkJSON(ctx, rawKeys[1], nil, OrderAscending, func(_, key, _ []byte) bool {
+		keys = append(keys, string(key))
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["alice","osmo"]`, `["bob","atom"]`}, keys)
+
+	coll, err = schema.GetCollection("params")
+	require.NoError(t, err)
+	value, err = coll.GetJSON(ctx, []byte(`"item"`))
+	require.NoError(t, err)
+	require.Equal(t, `"hello"`, string(value))
+
+	_, err = schema.GetCollection("unknown")
+	require.ErrorContains(t, err, "unknown collection")
+}
@@ -0,0 +1,299 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// Triple defines a multipart key composed of three keys.
+type Triple[K1, K2, K3 any] struct {
+	key1 *K1
+	key2 *K2
+	key3 *K3
+}
+
+// K1 returns the first part of the key.
+// If not present the zero value is returned.
+func (t Triple[K1, K2, K3]) K1() (k1 K1) {
+	if t.key1 == nil {
+		return
+	}
+	return *t.key1
+}
+
+// K2 returns the second part of the key.
+// If not present the zero value is returned.
+func (t Triple[K1, K2, K3]) K2() (k2 K2) {
+	if t.key2 == nil {
+		return
+	}
+	return *t.key2
+}
+
+// K3 returns the third part of the key.
+// If not present the zero value is returned.
+func (t Triple[K1, K2, K3]) K3() (k3 K3) {
+	if t.key3 == nil {
+		return
+	}
+	return *t.key3
+}
+
+// Join3 creates a new Triple instance composed of the three provided keys, in order.
+func Join3[K1, K2, K3 any](key1 K1, key2 K2, key3 K3) Triple[K1, K2, K3] {
+	return Triple[K1, K2, K3]{
+		key1: &key1,
+		key2: &key2,
+		key3: &key3,
+	}
+}
+
+// TriplePrefix creates a new Triple instance composed only of the first part of the key.
+func TriplePrefix[K1, K2, K3 any](key1 K1) Triple[K1, K2, K3] {
+	return Triple[K1, K2, K3]{key1: &key1}
+}
+
+// TripleSuperPrefix creates a new Triple instance composed only of the first two parts of the key.
+func TripleSuperPrefix[K1, K2, K3 any](key1 K1, key2 K2) Triple[K1, K2, K3] {
+	return Triple[K1, K2, K3]{key1: &key1, key2: &key2}
+}
+
+// TripleKeyCodec instantiates a new KeyCodec instance that can encode the Triple, given
+// the KeyCodecs of the three parts of the key, in order.
+func TripleKeyCodec[K1, K2, K3 any](
+	keyCodec1 codec.KeyCodec[K1],
+	keyCodec2 codec.KeyCodec[K2],
+	keyCodec3 codec.KeyCodec[K3],
+) codec.KeyCodec[Triple[K1, K2, K3]] {
+	return tripleKeyCodec[K1, K2, K3]{
+		keyCodec1: keyCodec1,
+		keyCodec2: keyCodec2,
+		keyCodec3: keyCodec3,
+	}
+}
+
+type tripleKeyCodec[K1, K2, K3 any] struct {
+	keyCodec1 codec.KeyCodec[K1]
+	keyCodec2 codec.KeyCodec[K2]
+	keyCodec3 codec.KeyCodec[K3]
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) KeyCodec1() codec.KeyCodec[K1] { return t.keyCodec1 }
+
+func (t tripleKeyCodec[K1, K2, K3]) KeyCodec2() codec.KeyCodec[K2] { return t.keyCodec2 }
+
+func (t tripleKeyCodec[K1, K2, K3]) KeyCodec3() codec.KeyCodec[K3] { return t.keyCodec3 }
+
+func (t tripleKeyCodec[K1, K2, K3]) Encode(buffer []byte, key Triple[K1, K2, K3]) (int, error) {
+	writtenTotal := 0
+	if key.key1 != nil {
+		written, err := t.keyCodec1.EncodeNonTerminal(buffer, *key.key1)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.key2 != nil {
+		written, err := t.keyCodec2.EncodeNonTerminal(buffer[writtenTotal:], *key.key2)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.key3 != nil {
+		written, err := t.keyCodec3.Encode(buffer[writtenTotal:], *key.key3)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) Decode(buffer []byte) (int, Triple[K1, K2, K3], error) {
+	readTotal := 0
+	read, key1, err := t.keyCodec1.DecodeNonTerminal(buffer)
+	if err != nil {
+		return 0, Triple[K1, K2, K3]{}, err
+	}
+	readTotal += read
+	read, key2, err := t.keyCodec2.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Triple[K1, K2, K3]{}, err
+	}
+	readTotal += read
+	read, key3, err := t.keyCodec3.Decode(buffer[readTotal:])
+	if err != nil {
+		return 0, Triple[K1, K2, K3]{}, err
+	}
+	readTotal += read
+	return readTotal, Join3(key1, key2, key3), nil
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) Size(key Triple[K1, K2, K3]) int {
+	size := 0
+	if key.key1 != nil {
+		size += t.keyCodec1.SizeNonTerminal(*key.key1)
+	}
+	if key.key2 != nil {
+		size += t.keyCodec2.SizeNonTerminal(*key.key2)
+	}
+	if key.key3 != nil {
+		size += t.keyCodec3.Size(*key.key3)
+	}
+	return size
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) EncodeNonTerminal(buffer []byte, key Triple[K1, K2, K3]) (int, error) {
+	writtenTotal := 0
+	if key.key1 != nil {
+		written, err := t.keyCodec1.EncodeNonTerminal(buffer, *key.key1)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.key2 != nil {
+		written, err := t.keyCodec2.EncodeNonTerminal(buffer[writtenTotal:], *key.key2)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	if key.key3 != nil {
+		written, err := t.keyCodec3.EncodeNonTerminal(buffer[writtenTotal:], *key.key3)
+		if err != nil {
+			return 0, err
+		}
+		writtenTotal += written
+	}
+	return writtenTotal, nil
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) DecodeNonTerminal(buffer []byte) (int, Triple[K1, K2, K3], error) {
+	readTotal := 0
+	read, key1, err := t.keyCodec1.DecodeNonTerminal(buffer)
+	if err != nil {
+		return 0, Triple[K1, K2, K3]{}, err
+	}
+	readTotal += read
+	read, key2, err := t.keyCodec2.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Triple[K1, K2, K3]{}, err
+	}
+	readTotal += read
+	read, key3, err := t.keyCodec3.DecodeNonTerminal(buffer[readTotal:])
+	if err != nil {
+		return 0, Triple[K1, K2, K3]{}, err
+	}
+	readTotal += read
+	return readTotal, Join3(key1, key2, key3), nil
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) SizeNonTerminal(key Triple[K1, K2, K3]) int {
+	size := 0
+	if key.key1 != nil {
+		size += t.keyCodec1.SizeNonTerminal(*key.key1)
+	}
+	if key.key2 != nil {
+		size += t.keyCodec2.SizeNonTerminal(*key.key2)
+	}
+	if key.key3 != nil {
+		size += t.keyCodec3.SizeNonTerminal(*key.key3)
+	}
+	return size
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) Stringify(key Triple[K1, K2, K3]) string {
+	b := new(strings.Builder)
+	b.WriteByte('(')
+	writeKeyPart(b, key.key1, t.keyCodec1)
+	b.WriteString(", ")
+	writeKeyPart(b, key.key2, t.keyCodec2)
+	b.WriteString(", ")
+	writeKeyPart(b, key.key3, t.keyCodec3)
+	b.WriteByte(')')
+	return b.String()
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) KeyType() string {
+	return fmt.Sprintf("Triple[%s, %s, %s]", t.keyCodec1.KeyType(), t.keyCodec2.KeyType(), t.keyCodec3.KeyType())
+}
+
+// writeKeyPart writes the quoted string representation of a part of a multipart
+// key to the builder, or <nil> if the part is not present.
+func writeKeyPart[K any](b *strings.Builder, key *K, keyCodec codec.KeyCodec[K]) {
+	if key == nil {
+		b.WriteString("<nil>")
+		return
+	}
+	b.WriteByte('"')
+	b.WriteString(keyCodec.Stringify(*key))
+	b.WriteByte('"')
+}
+
+// GENESIS
+
+type jsonTripleKey [3]json.RawMessage
+
+func (t tripleKeyCodec[K1, K2, K3]) EncodeJSON(v Triple[K1, K2, K3]) ([]byte, error) {
+	k1JSON, err := t.keyCodec1.EncodeJSON(v.K1())
+	if err != nil {
+		return nil, err
+	}
+	k2JSON, err := t.keyCodec2.EncodeJSON(v.K2())
+	if err != nil {
+		return nil, err
+	}
+	k3JSON, err := t.keyCodec3.EncodeJSON(v.K3())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonTripleKey{k1JSON, k2JSON, k3JSON})
+}
+
+func (t tripleKeyCodec[K1, K2, K3]) DecodeJSON(b []byte) (Triple[K1, K2, K3], error) {
+	tripleJSON := jsonTripleKey{}
+	err := json.Unmarshal(b, &tripleJSON)
+	if err != nil {
+		return Triple[K1, K2, K3]{}, err
+	}
+
+	k1, err := t.keyCodec1.DecodeJSON(tripleJSON[0])
+	if err != nil {
+		return Triple[K1, K2, K3]{}, err
+	}
+	k2, err := t.keyCodec2.DecodeJSON(tripleJSON[1])
+	if err != nil {
+		return Triple[K1, K2, K3]{}, err
+	}
+	k3, err := t.keyCodec3.DecodeJSON(tripleJSON[2])
+	if err != nil {
+		return Triple[K1, K2, K3]{}, err
+	}
+
+	return Join3(k1, k2, k3), nil
+}
+
+// NewPrefixedTripleRange creates a new Range which will prefix over all the keys
+// starting with the provided first part of the key.
+func NewPrefixedTripleRange[K1, K2, K3 any](k1 K1) *Range[Triple[K1, K2, K3]] {
+	key := TriplePrefix[K1, K2, K3](k1)
+	return &Range[Triple[K1, K2, K3]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
+
+// NewSuperPrefixedTripleRange creates a new Range which will prefix over all the keys
+// starting with the provided first and second parts of the key.
+func NewSuperPrefixedTripleRange[K1, K2, K3 any](k1 K1, k2 K2) *Range[Triple[K1, K2, K3]] {
+	key := TripleSuperPrefix[K1, K2, K3](k1, k2)
+	return &Range[Triple[K1, K2, K3]]{
+		start: RangeKeyExact(key),
+		end:   RangeKeyPrefixEnd(key),
+	}
+}
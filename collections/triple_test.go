@@ -0,0 +1,79 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriple(t *testing.T) {
+	keyCodec := TripleKeyCodec(StringKey, StringKey, StringKey)
+	t.Run("stringify", func(t *testing.T) {
+		s := keyCodec.Stringify(Join3("a", "b", "c"))
+		require.Equal(t, `("a", "b", "c")`, s)
+		s = keyCodec.Stringify(TripleSuperPrefix[string, string, string]("a", "b"))
+		require.Equal(t, `("a", "b", <nil>)`, s)
+		s = keyCodec.Stringify(TriplePrefix[string, string, string]("a"))
+		require.Equal(t, `("a", <nil>, <nil>)`, s)
+		s = keyCodec.Stringify(Triple[string, string, string]{})
+		require.Equal(t, `(<nil>, <nil>, <nil>)`, s)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := keyCodec.EncodeJSON(Join3("k1", "k2", "k3"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(`["k1","k2","k3"]`), b)
+
+		key, err := keyCodec.DecodeJSON(b)
+		require.NoError(t, err)
+		require.Equal(t, Join3("k1", "k2", "k3"), key)
+	})
+
+	t.Run("key type", func(t *testing.T) {
+		require.Equal(t, "Triple[string, string, string]", keyCodec.KeyType())
+	})
+}
+
+func TestTripleRange(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	kc := TripleKeyCodec(StringKey, StringKey, Uint64Key)
+	m := NewMap(schema, NewPrefix(0), "triple", kc, Uint64Value)
+
+	require.NoError(t, m.Set(ctx, Join3("A", "X", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join3("A", "X", uint64(1)), 0))
+	require.NoError(t, m.Set(ctx, Join3("A", "Y", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join3("AB", "X", uint64(0)), 0))
+	require.NoError(t, m.Set(ctx, Join3("B", "X", uint64(0)), 0))
+
+	// expect the whole "A" prefix
+	iter, err := m.Iterate(ctx, NewPrefixedTripleRange[string, string, uint64]("A"))
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Triple[string, string, uint64]{
+		Join3("A", "X", uint64(0)),
+		Join3("A", "X", uint64(1)),
+		Join3("A", "Y", uint64(0)),
+	}, keys)
+
+	// expect only the "A", "X" prefix
+	iter, err = m.Iterate(ctx, NewSuperPrefixedTripleRange[string, string, uint64]("A", "X"))
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Triple[string, string, uint64]{
+		Join3("A", "X", uint64(0)),
+		Join3("A", "X", uint64(1)),
+	}, keys)
+
+	// expect the "A", "X" prefix in reverse
+	iter, err = m.Iterate(ctx, NewSuperPrefixedTripleRange[string, string, uint64]("A", "X").Descending())
+	require.NoError(t, err)
+	keys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []Triple[string, string, uint64]{
+		Join3("A", "X", uint64(1)),
+		Join3("A", "X", uint64(0)),
+	}, keys)
+}
@@ -33,6 +33,12 @@ var (
 	ErrLedgerInvalidSignature = errors.New("Ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
 	// ErrLegacyToRecord is raised when cannot be converted to a Record
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
+	// ErrNotRemoteObj is raised when record.IsRemote() returns false.
+	ErrNotRemoteObj = errors.New("not a remote object")
+	// ErrRemoteSignerMissing is raised when signing with a remote record in a keyring without remote signer.
+	ErrRemoteSignerMissing = errors.New("cannot sign with remote keys without a remote signer")
+	// ErrRemoteSignerInvalidSignature is raised when the remote signer generates an invalid signature.
+	ErrRemoteSignerInvalidSignature = errors.New("remote signer generated an invalid signature")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
 )
@@ -93,6 +93,10 @@ type Keyring interface {
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
+	// SaveRemoteKey stores a reference to a key of the remote signer, whose signatures are
+	// delegated to the remote signer.
+	SaveRemoteKey(uid string, pubkey types.PubKey) (*Record, error)
+
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -157,6 +161,8 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// remote signer signing with the remote keys, whose private keys are held by an external process
+	RemoteSigner RemoteSignerClient
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -383,6 +389,12 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.IsRemote():
+		if ks.options.RemoteSigner == nil {
+			return nil, nil, ErrRemoteSignerMissing
+		}
+		return SignWithRemoteSigner(ks.options.RemoteSigner, k, msg, signMode)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -434,6 +446,15 @@ func (ks keystore) SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, err
 	return ks.writeOfflineKey(uid, pubkey)
 }
 
+func (ks keystore) SaveRemoteKey(uid string, pubkey types.PubKey) (*Record, error) {
+	k, err := NewRemoteRecord(uid, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) DeleteByAddress(address sdk.Address) error {
 	k, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -60,6 +60,13 @@ func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordOfflineItem)
 }
 
+// NewRemoteRecord creates a new Record with offline item, marked as a key held by the remote signer
+func NewRemoteRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
+	recordOffline := &Record_Offline{Remote: true}
+	recordOfflineItem := &Record_Offline_{recordOffline}
+	return newRecord(name, pk, recordOfflineItem)
+}
+
 // NewMultiRecord creates a new Record with multi item
 func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordMulti := &Record_Multi{}
@@ -102,6 +109,12 @@ func (k Record) GetType() KeyType {
 	}
 }
 
+// IsRemote returns true if the record is an offline record of a key held by the remote signer of the keyring
+func (k Record) IsRemote() bool {
+	offline := k.GetOffline()
+	return offline != nil && offline.Remote
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (k *Record) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var pk cryptotypes.PubKey
@@ -259,6 +259,10 @@ var xxx_messageInfo_Record_Multi proto.InternalMessageInfo
 
 // Offline item
 type Record_Offline struct {
+	// remote marks the keys held by the remote signer of the keyring, which signs with them.
+	//
+	// Since: cosmos-sdk 0.48
+	Remote bool `protobuf:"varint,1,opt,name=remote,proto3" json:"remote,omitempty"`
 }
 
 func (m *Record_Offline) Reset()         { *m = Record_Offline{} }
@@ -307,33 +311,34 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8b, 0xd4, 0x30,
+	0x1c, 0xc5, 0x53, 0x6d, 0xd3, 0x35, 0xde, 0xc2, 0x22, 0xb5, 0x48, 0x18, 0x05, 0x75, 0x40, 0x36,
+	0x61, 0x75, 0x0e, 0x9e, 0x16, 0x76, 0xf0, 0x30, 0xb2, 0x2e, 0x2e, 0x39, 0x7a, 0x91, 0xfe, 0xc8,
+	0xb4, 0x65, 0xda, 0xa6, 0xa4, 0xed, 0x40, 0xaf, 0xfe, 0x05, 0x1e, 0xfd, 0x93, 0xf6, 0xb8, 0x47,
+	0x8f, 0x3a, 0xfd, 0x47, 0x24, 0x49, 0xe7, 0xe0, 0x80, 0xce, 0x9e, 0x9a, 0xd0, 0xcf, 0xfb, 0xbe,
+	0xf7, 0x92, 0xa0, 0x97, 0x89, 0x6c, 0x2b, 0xd9, 0xb2, 0x44, 0x0d, 0x4d, 0x27, 0xd9, 0x46, 0x0c,
+	0xaa, 0xa8, 0x33, 0xb6, 0x3d, 0x67, 0x4a, 0x24, 0x52, 0xa5, 0xb4, 0x51, 0xb2, 0x93, 0x38, 0xb0,
+	0x18, 0xb5, 0x18, 0x9d, 0x30, 0xba, 0x3d, 0x0f, 0x4f, 0x33, 0x99, 0x49, 0x03, 0x31, 0xbd, 0xb2,
+	0x7c, 0xf8, 0x34, 0x93, 0x32, 0x2b, 0x05, 0x33, 0xbb, 0xb8, 0x5f, 0xb3, 0xa8, 0x1e, 0xa6, 0x5f,
+	0xcf, 0xfe, 0x76, 0xcc, 0x53, 0x6d, 0x96, 0x4f, 0x46, 0x2f, 0xbe, 0xb9, 0x08, 0x72, 0xe3, 0x8c,
+	0x31, 0x72, 0xeb, 0xa8, 0x12, 0x81, 0x33, 0x73, 0xe6, 0x8f, 0xb8, 0x59, 0xe3, 0x33, 0xe4, 0x37,
+	0x7d, 0xfc, 0x75, 0x23, 0x86, 0xe0, 0xc1, 0xcc, 0x99, 0x3f, 0x7e, 0x7b, 0x4a, 0xad, 0x13, 0xdd,
+	0x3b, 0xd1, 0xcb, 0x7a, 0xe0, 0xb0, 0xe9, 0xe3, 0x2b, 0x31, 0xe0, 0x0b, 0xe4, 0x95, 0x32, 0x89,
+	0xca, 0xe0, 0xa1, 0x81, 0x5f, 0xd1, 0x7f, 0xd5, 0xa0, 0xd6, 0x93, 0x7e, 0xd2, 0xf4, 0x0a, 0x70,
+	0x2b, 0xc3, 0x97, 0x08, 0x96, 0x22, 0xcd, 0x84, 0x0a, 0x5c, 0x33, 0xe0, 0xf5, 0xf1, 0x01, 0x06,
+	0x5f, 0x01, 0x3e, 0x09, 0x75, 0x84, 0xaa, 0x2f, 0xbb, 0x22, 0xf0, 0xee, 0x19, 0xe1, 0x5a, 0xd3,
+	0x3a, 0x82, 0x91, 0xe1, 0x0f, 0xc8, 0x97, 0xeb, 0x75, 0x59, 0xd4, 0x22, 0x80, 0x66, 0xc2, 0xfc,
+	0xe8, 0x84, 0xcf, 0x96, 0x5f, 0x01, 0xbe, 0x97, 0x86, 0xef, 0x91, 0x67, 0xaa, 0x61, 0x86, 0x4e,
+	0x1a, 0x55, 0x6c, 0xcd, 0x09, 0x3a, 0xff, 0x39, 0x41, 0x5f, 0x53, 0x57, 0x62, 0x08, 0x2f, 0x10,
+	0xb4, 0x9d, 0xf0, 0x02, 0xb9, 0x4d, 0xd4, 0xe5, 0x93, 0x6c, 0x76, 0x10, 0x23, 0x4f, 0x75, 0x82,
+	0xe5, 0xc7, 0x9b, 0xc5, 0xe2, 0x26, 0x52, 0x51, 0xd5, 0x72, 0x43, 0x87, 0x3e, 0xf2, 0x4c, 0xa3,
+	0xf0, 0x39, 0xf2, 0xa7, 0x60, 0xf8, 0x09, 0x82, 0x4a, 0x54, 0xb2, 0xb3, 0x77, 0x7b, 0xc2, 0xa7,
+	0xdd, 0x12, 0x22, 0xb7, 0xe8, 0x44, 0xb5, 0xbc, 0xbe, 0xfd, 0x4d, 0xc0, 0xed, 0x8e, 0x38, 0x77,
+	0x3b, 0xe2, 0xfc, 0xda, 0x11, 0xe7, 0xfb, 0x48, 0xc0, 0x8f, 0x91, 0x80, 0xbb, 0x91, 0x80, 0x9f,
+	0x23, 0x01, 0x5f, 0xde, 0x64, 0x45, 0x97, 0xf7, 0x31, 0x4d, 0x64, 0xc5, 0xf6, 0xef, 0xc9, 0x7c,
+	0xce, 0xda, 0x74, 0x73, 0xf0, 0x98, 0x63, 0x68, 0x9a, 0xbd, 0xfb, 0x33, 0x00, 0x65, 0x68, 0xd5,
+	0xd5, 0xec, 0x02, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -584,6 +589,16 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Remote {
+		i--
+		if m.Remote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -707,6 +722,9 @@ func (m *Record_Offline) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Remote {
+		n += 2
+	}
 	return n
 }
 
@@ -1225,6 +1243,26 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Offline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remote = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
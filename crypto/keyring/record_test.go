@@ -47,6 +47,25 @@ func (s *RecordTestSuite) TestOfflineRecordMarshaling() {
 	s.Require().True(s.pub.Equals(pk2))
 }
 
+func (s *RecordTestSuite) TestRemoteRecordMarshaling() {
+	k, err := NewRemoteRecord("testrecord", s.pub)
+	s.Require().NoError(err)
+
+	bz, err := s.cdc.Marshal(k)
+	s.Require().NoError(err)
+
+	var k2 Record
+	s.Require().NoError(s.cdc.Unmarshal(bz, &k2))
+	s.Require().Equal(TypeOffline, k2.GetType())
+	s.Require().True(k2.IsRemote())
+
+	// the remote flag is skipped by the older binaries, whose offline item is an empty message
+	offlineBz, err := k2.GetOffline().Marshal()
+	s.Require().NoError(err)
+	s.Require().NotEmpty(offlineBz)
+	s.Require().NoError((&Record_Multi{}).Unmarshal(offlineBz))
+}
+
 func (s *RecordTestSuite) TestLocalRecordMarshaling() {
 	dir := s.T().TempDir()
 	mockIn := strings.NewReader("")
@@ -0,0 +1,116 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// RemoteSignerTimeout is the time the remote signer has to answer a signing request.
+const RemoteSignerTimeout = time.Minute
+
+// WithRemoteSigner sets the remote signer the keyring delegates the signing of its remote keys to. The keys of
+// the remote signer are registered in the keyring with SaveRemoteKey, so their private keys never leave the signer.
+func WithRemoteSigner(signer RemoteSignerClient) Option {
+	return func(options *Options) {
+		options.RemoteSigner = signer
+	}
+}
+
+// FetchRemoteSignerPubKey returns the public key of the key of the remote signer with the provided name.
+func FetchRemoteSignerPubKey(ctx context.Context, signer RemoteSignerClient, cdc codectypes.AnyUnpacker, name string) (types.PubKey, error) {
+	res, err := signer.PubKey(ctx, &RemoteSignerPubKeyRequest{Name: name})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "can't fetch the public key of %s from the remote signer", name)
+	}
+
+	var pk types.PubKey
+	if err := cdc.UnpackAny(res.PubKey, &pk); err != nil {
+		return nil, err
+	}
+	return pk, nil
+}
+
+// SignWithRemoteSigner signs the msg with the remote signer holding the private key of the provided remote
+// record, which has RemoteSignerTimeout to answer. The signature is verified against the public key of the record.
+func SignWithRemoteSigner(signer RemoteSignerClient, k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	if !k.IsRemote() {
+		return nil, nil, ErrNotRemoteObj
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteSignerTimeout)
+	defer cancel()
+
+	res, err := signer.Sign(ctx, &RemoteSignerSignRequest{
+		PubKey:   k.PubKey,
+		Msg:      msg,
+		SignMode: signMode,
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "remote signer failed to sign with %s", k.Name)
+	}
+
+	if !pub.VerifySignature(msg, res.Signature) {
+		return nil, nil, ErrRemoteSignerInvalidSignature
+	}
+
+	return res.Signature, pub, nil
+}
+
+// remoteSignerServer serves the keys of a keyring to the keyrings using it as their remote signer.
+type remoteSignerServer struct {
+	UnimplementedRemoteSignerServer
+
+	kr  Keyring
+	cdc codectypes.AnyUnpacker
+}
+
+// NewRemoteSignerServer returns a RemoteSignerServer signing with the keys of the provided keyring, which can be
+// used as a stand-in for an external signing process.
+func NewRemoteSignerServer(kr Keyring, cdc codectypes.AnyUnpacker) RemoteSignerServer {
+	return remoteSignerServer{kr: kr, cdc: cdc}
+}
+
+func (s remoteSignerServer) PubKey(_ context.Context, req *RemoteSignerPubKeyRequest) (*RemoteSignerPubKeyResponse, error) {
+	k, err := s.kr.Key(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoteSignerPubKeyResponse{PubKey: k.PubKey}, nil
+}
+
+func (s remoteSignerServer) Sign(_ context.Context, req *RemoteSignerSignRequest) (*RemoteSignerSignResponse, error) {
+	var pk types.PubKey
+	if err := s.cdc.UnpackAny(req.PubKey, &pk); err != nil {
+		return nil, err
+	}
+
+	k, err := s.kr.KeyByAddress(sdk.AccAddress(pk.Address()))
+	if err != nil {
+		return nil, err
+	}
+
+	if k.GetOffline() != nil || k.GetMulti() != nil {
+		return nil, fmt.Errorf("%s is an offline key of the remote signer", k.Name)
+	}
+
+	sig, _, err := s.kr.Sign(k.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoteSignerSignResponse{Signature: sig}, nil
+}
@@ -0,0 +1,164 @@
+package keyring
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// startRemoteSigner serves the keys of the provided keyring as a remote signer and returns a client of it.
+func startRemoteSigner(t *testing.T, signerKr Keyring) RemoteSignerClient {
+	t.Helper()
+
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewRemoteSignerServer(signerKr, getCodec()))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return NewRemoteSignerClient(conn)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	cdc := getCodec()
+	signerKr := NewInMemory(cdc)
+	signerRecord, _, err := signerKr.NewMnemonic("validator", English, types.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signerPub, err := signerRecord.GetPubKey()
+	require.NoError(t, err)
+
+	remoteSigner := startRemoteSigner(t, signerKr)
+	kr := NewInMemory(cdc, WithRemoteSigner(remoteSigner))
+
+	// the remote key is registered as an offline key marked as remote
+	pub, err := FetchRemoteSignerPubKey(context.Background(), remoteSigner, cdc, "validator")
+	require.NoError(t, err)
+	require.True(t, signerPub.Equals(pub))
+
+	_, err = FetchRemoteSignerPubKey(context.Background(), remoteSigner, cdc, "unknown")
+	require.Error(t, err)
+
+	record, err := kr.SaveRemoteKey("remote", pub)
+	require.NoError(t, err)
+	require.Equal(t, TypeOffline, record.GetType())
+	require.True(t, record.IsRemote())
+	record, err = kr.Key("remote")
+	require.NoError(t, err)
+	require.Equal(t, TypeOffline, record.GetType())
+	require.True(t, record.IsRemote())
+
+	// the signatures of the remote key are delegated to the remote signer
+	msg := []byte("message")
+	sig, sigPub, err := kr.Sign("remote", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, signerPub.Equals(sigPub))
+	require.True(t, signerPub.VerifySignature(msg, sig))
+
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, signerPub.VerifySignature(msg, sig))
+
+	// the remote signer refuses to sign with keys it doesn't hold
+	otherKr := NewInMemory(cdc)
+	otherRecord, _, err := otherKr.NewMnemonic("other", English, types.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	otherPub, err := otherRecord.GetPubKey()
+	require.NoError(t, err)
+	_, err = kr.SaveRemoteKey("other", otherPub)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("other", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "remote signer failed to sign with other")
+
+	// the offline keys added with their public key aren't delegated to the remote signer, even if it holds them
+	offlineKr := NewInMemory(cdc, WithRemoteSigner(remoteSigner))
+	record, err = offlineKr.SaveOfflineKey("offline", pub)
+	require.NoError(t, err)
+	require.False(t, record.IsRemote())
+	_, _, err = offlineKr.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrOfflineSign)
+
+	// remote keys can't sign without a remote signer
+	noSignerKr := NewInMemory(cdc)
+	_, err = noSignerKr.SaveRemoteKey("remote", pub)
+	require.NoError(t, err)
+	_, _, err = noSignerKr.Sign("remote", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrRemoteSignerMissing)
+}
+
+func TestRemoteSignerInvalidSignature(t *testing.T) {
+	cdc := getCodec()
+	signerKr := NewInMemory(cdc)
+	_, _, err := signerKr.NewMnemonic("validator", English, types.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	remoteSigner := startRemoteSigner(t, signerKr)
+	pub, err := FetchRemoteSignerPubKey(context.Background(), remoteSigner, cdc, "validator")
+	require.NoError(t, err)
+
+	record, err := NewRemoteRecord("remote", pub)
+	require.NoError(t, err)
+
+	// a signer returning signatures of other bytes is rejected
+	_, _, err = SignWithRemoteSigner(tamperingRemoteSigner{remoteSigner}, record, []byte("message"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrRemoteSignerInvalidSignature)
+
+	offlineRecord, err := NewOfflineRecord("offline", pub)
+	require.NoError(t, err)
+	_, _, err = SignWithRemoteSigner(remoteSigner, offlineRecord, []byte("message"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrNotRemoteObj)
+}
+
+func TestRemoteSignerTimeout(t *testing.T) {
+	pub := secp256k1.GenPrivKey().PubKey()
+	record, err := NewRemoteRecord("remote", pub)
+	require.NoError(t, err)
+
+	// the signing requests have a deadline
+	signer := &deadlineRemoteSigner{}
+	_, _, err = SignWithRemoteSigner(signer, record, []byte("message"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, signer.hasDeadline)
+	require.WithinDuration(t, time.Now().Add(RemoteSignerTimeout), signer.deadline, time.Second)
+}
+
+// deadlineRemoteSigner records the deadline of the signing requests and fails them as timed out.
+type deadlineRemoteSigner struct {
+	RemoteSignerClient
+
+	deadline    time.Time
+	hasDeadline bool
+}
+
+func (s *deadlineRemoteSigner) Sign(ctx context.Context, _ *RemoteSignerSignRequest, _ ...grpc.CallOption) (*RemoteSignerSignResponse, error) {
+	s.deadline, s.hasDeadline = ctx.Deadline()
+	return nil, context.DeadlineExceeded
+}
+
+// tamperingRemoteSigner signs other bytes than the requested ones.
+type tamperingRemoteSigner struct {
+	RemoteSignerClient
+}
+
+func (s tamperingRemoteSigner) Sign(ctx context.Context, req *RemoteSignerSignRequest, opts ...grpc.CallOption) (*RemoteSignerSignResponse, error) {
+	req.Msg = append(req.Msg, 'x')
+	return s.RemoteSignerClient.Sign(ctx, req, opts...)
+}
@@ -19,6 +19,7 @@ require (
 	github.com/cockroachdb/apd/v2 v2.0.2
 	github.com/cockroachdb/errors v1.9.1
 	github.com/cometbft/cometbft v0.37.0
+	github.com/cometbft/cometbft-db v0.7.0
 	github.com/cosmos/btcutil v1.0.5
 	github.com/cosmos/cosmos-db v1.0.0-rc.1
 	github.com/cosmos/cosmos-proto v1.0.0-beta.3
@@ -77,7 +78,6 @@ require (
 	github.com/cockroachdb/logtags v0.0.0-20230118201751-21c54148d20b // indirect
 	github.com/cockroachdb/pebble v0.0.0-20230315223031-1e5ddd10389e // indirect
 	github.com/cockroachdb/redact v1.1.3 // indirect
-	github.com/cometbft/cometbft-db v0.7.0 // indirect
 	github.com/confio/ics23/go v0.9.0 // indirect
 	github.com/cosmos/iavl v0.21.0-beta.1 // indirect
 	github.com/creachadair/taskgroup v0.4.2 // indirect
@@ -158,6 +158,14 @@ require (
 	nhooyr.io/websocket v1.8.6 // indirect
 )
 
+// Replace here are pending PRs, or version to be tagged
+replace (
+	// TODO tag api, collections and store once the collections query service and delta snapshots are released
+	cosmossdk.io/api => ./api
+	cosmossdk.io/collections => ./collections
+	cosmossdk.io/store => ./store
+)
+
 // Below are the long-lived replace of the Cosmos SDK
 replace (
 	// use cosmos fork of keyring
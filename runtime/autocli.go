@@ -3,6 +3,7 @@ package runtime
 import (
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	collectionsv1 "cosmossdk.io/api/cosmos/collections/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 )
 
@@ -25,6 +26,27 @@ func (m appModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 				},
 			},
+			"collections": {
+				Service: collectionsv1.Query_ServiceDesc.ServiceName,
+				RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+					{
+						RpcMethod: "Schema",
+						Short:     "Queries the collections of the app modules",
+					},
+					{
+						RpcMethod:      "Get",
+						Use:            "get [module] [collection] [key]",
+						Short:          "Queries the value of a collection associated with a JSON encoded key",
+						PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}, {ProtoField: "collection"}, {ProtoField: "key"}},
+					},
+					{
+						RpcMethod:      "Entries",
+						Use:            "entries [module] [collection]",
+						Short:          "Queries the entries of a collection",
+						PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}, {ProtoField: "collection"}},
+					},
+				},
+			},
 			"reflection": {
 				Service: reflectionv1.ReflectionService_ServiceDesc.ServiceName,
 				RpcCommandOptions: []*autocliv1.RpcCommandOptions{
@@ -2,9 +2,12 @@ package runtime
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	dbm "github.com/cosmos/cosmos-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -41,6 +44,13 @@ func (a *AppBuilder) Build(
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(a.app.interfaceRegistry)
 	bApp.MountStores(a.app.storeKeys...)
+	for _, cfg := range a.app.config.StoreGasConfigs {
+		storeKey := a.app.UnsafeFindStoreKey(cfg.KvStoreKey)
+		if storeKey == nil {
+			panic(fmt.Errorf("unknown store key %s in the store gas configs", cfg.KvStoreKey))
+		}
+		bApp.SetStoreGasConfig(storeKey, storeGasConfig(storeKey, cfg))
+	}
 
 	a.app.BaseApp = bApp
 	a.app.configurator = module.NewConfigurator(a.app.cdc, a.app.MsgServiceRouter(), a.app.GRPCQueryRouter())
@@ -51,3 +61,26 @@ func (a *AppBuilder) Build(
 
 	return a.app
 }
+
+// storeGasConfig returns the default gas config of the store with the given key, the
+// transient or KV store one, with the non-zero costs of cfg.
+func storeGasConfig(key storetypes.StoreKey, cfg *runtimev1alpha1.StoreGasConfig) storetypes.GasConfig {
+	gasConfig := storetypes.KVGasConfig()
+	if _, ok := key.(*storetypes.TransientStoreKey); ok {
+		gasConfig = storetypes.TransientGasConfig()
+	}
+	override := func(cost *storetypes.Gas, value uint64) {
+		if value != 0 {
+			*cost = value
+		}
+	}
+
+	override(&gasConfig.HasCost, cfg.HasCost)
+	override(&gasConfig.DeleteCost, cfg.DeleteCost)
+	override(&gasConfig.ReadCostFlat, cfg.ReadCostFlat)
+	override(&gasConfig.ReadCostPerByte, cfg.ReadCostPerByte)
+	override(&gasConfig.WriteCostFlat, cfg.WriteCostFlat)
+	override(&gasConfig.WriteCostPerByte, cfg.WriteCostPerByte)
+	override(&gasConfig.IterNextCostFlat, cfg.IterNextCostFlat)
+	return gasConfig
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	collectionsv1 "cosmossdk.io/api/cosmos/collections/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 
 	"github.com/cosmos/cosmos-sdk/runtime/services"
@@ -12,6 +13,7 @@ import (
 func (a *App) registerRuntimeServices(cfg module.Configurator) error {
 	appv1alpha1.RegisterQueryServer(cfg.QueryServer(), services.NewAppQueryService(a.appConfig))
 	autocliv1.RegisterQueryServer(cfg.QueryServer(), services.NewAutoCLIQueryService(a.ModuleManager.Modules))
+	collectionsv1.RegisterQueryServer(cfg.QueryServer(), services.NewCollectionsQueryService(a.ModuleManager.Modules))
 
 	reflectionSvc, err := services.NewReflectionService()
 	if err != nil {
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	collectionsv1 "cosmossdk.io/api/cosmos/collections/v1"
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultEntriesLimit is the number of entries returned by Query/Entries when
+	// no pagination limit is provided.
+	defaultEntriesLimit = 100
+	// maxEntriesLimit is the maximum number of entries returned by Query/Entries,
+	// larger pagination limits are capped to it.
+	maxEntriesLimit = 1000
+)
+
+// CollectionsQueryService implements the cosmos.collections.v1.Query service.
+type CollectionsQueryService struct {
+	collectionsv1.UnimplementedQueryServer
+
+	moduleNames []string
+	schemas     map[string]collections.Schema
+}
+
+// NewCollectionsQueryService returns a CollectionsQueryService for the provided modules.
+// Only the modules exposing their collections.Schema through a CollectionsSchema method
+// are queryable.
+func NewCollectionsQueryService(appModules map[string]interface{}) *CollectionsQueryService {
+	svc := &CollectionsQueryService{
+		schemas: map[string]collections.Schema{},
+	}
+	for modName, mod := range appModules {
+		if collMod, ok := mod.(interface {
+			CollectionsSchema() collections.Schema
+		}); ok {
+			svc.moduleNames = append(svc.moduleNames, modName)
+			svc.schemas[modName] = collMod.CollectionsSchema()
+		}
+	}
+	sort.Strings(svc.moduleNames)
+
+	return svc
+}
+
+func (c CollectionsQueryService) Schema(_ context.Context, req *collectionsv1.QuerySchemaRequest) (*collectionsv1.QuerySchemaResponse, error) {
+	moduleNames := c.moduleNames
+	if req.Module != "" {
+		if _, ok := c.schemas[req.Module]; !ok {
+			return nil, status.Errorf(codes.NotFound, "module %s has no collections schema", req.Module)
+		}
+		moduleNames = []string{req.Module}
+	}
+
+	modules := make([]*collectionsv1.ModuleSchema, 0, len(moduleNames))
+	for _, modName := range moduleNames {
+		colls := c.schemas[modName].ListCollections()
+		collSchemas := make([]*collectionsv1.CollectionSchema, 0, len(colls))
+		for _, coll := range colls {
+			collSchemas = append(collSchemas, &collectionsv1.CollectionSchema{
+				Name:      coll.GetName(),
+				Prefix:    coll.GetPrefix(),
+				KeyType:   coll.KeyType(),
+				ValueType: coll.ValueType(),
+			})
+		}
+		modules = append(modules, &collectionsv1.ModuleSchema{
+			Module:      modName,
+			Collections: collSchemas,
+		})
+	}
+
+	return &collectionsv1.QuerySchemaResponse{Modules: modules}, nil
+}
+
+func (c CollectionsQueryService) Get(ctx context.Context, req *collectionsv1.QueryGetRequest) (*collectionsv1.QueryGetResponse, error) {
+	coll, err := c.getCollection(req.Module, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := coll.GetJSON(ctx, []byte(req.Key))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "key %s not found in collection %s/%s", req.Key, req.Module, req.Collection)
+	case errors.Is(err, collections.ErrEncoding):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &collectionsv1.QueryGetResponse{Value: string(value)}, nil
+}
+
+func (c CollectionsQueryService) Entries(ctx context.Context, req *collectionsv1.QueryEntriesRequest) (*collectionsv1.QueryEntriesResponse, error) {
+	coll, err := c.getCollection(req.Module, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &queryv1beta1.PageRequest{}
+	}
+	if pageReq.Offset != 0 || pageReq.CountTotal {
+		return nil, status.Error(codes.InvalidArgument, "only key based pagination is supported")
+	}
+
+	limit := pageReq.Limit
+	switch {
+	case limit == 0:
+		limit = defaultEntriesLimit
+	case limit > maxEntriesLimit:
+		limit = maxEntriesLimit
+	}
+
+	// the page key is the raw key of the first entry of the page, which is the
+	// start of the range when iterating in ascending order, and the end of the
+	// range when iterating in descending order. As the end of a range is exclusive,
+	// we use the smallest key following the page key.
+	var start, end []byte
+	order := collections.OrderAscending
+	if pageReq.Reverse {
+		order = collections.OrderDescending
+		if pageReq.Key != nil {
+			end = append(append([]byte{}, pageReq.Key...), 0)
+		}
+	} else {
+		start = pageReq.Key
+	}
+
+	var (
+		entries []*collectionsv1.Entry
+		nextKey []byte
+	)
+	err = coll.WalkJSON(ctx, start, end, order, func(rawKey, key, value []byte) bool {
+		if uint64(len(entries)) == limit {
+			nextKey = append([]byte{}, rawKey...)
+			return true
+		}
+		entries = append(entries, &collectionsv1.Entry{Key: string(key), Value: string(value)})
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &collectionsv1.QueryEntriesResponse{
+		Entries:    entries,
+		Pagination: &queryv1beta1.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+func (c CollectionsQueryService) getCollection(module, name string) (collections.Collection, error) {
+	schema, ok := c.schemas[module]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s has no collections schema", module)
+	}
+
+	coll, err := schema.GetCollection(name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return coll, nil
+}
+
+var _ collectionsv1.QueryServer = &CollectionsQueryService{}
@@ -0,0 +1,165 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	collectionsv1 "cosmossdk.io/api/cosmos/collections/v1"
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/runtime/services"
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+type collectionsModule struct {
+	schema collections.Schema
+}
+
+func (m collectionsModule) CollectionsSchema() collections.Schema { return m.schema }
+
+func setupCollectionsQueryService(t *testing.T, entries int) (*services.CollectionsQueryService, context.Context) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	names := collections.NewMap(sb, collections.NewPrefix(0), "names", collections.StringKey, collections.StringValue)
+	_ = collections.NewMap(sb, collections.NewPrefix(1), "counters", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	for i := 0; i < entries; i++ {
+		require.NoError(t, names.Set(ctx, fmt.Sprintf("key%02d", i), fmt.Sprintf("value%02d", i)))
+	}
+
+	svc := services.NewCollectionsQueryService(map[string]interface{}{
+		"test":  collectionsModule{schema: schema},
+		"other": struct{}{},
+	})
+	return svc, ctx
+}
+
+func TestCollectionsQueryServiceSchema(t *testing.T) {
+	svc, ctx := setupCollectionsQueryService(t, 0)
+
+	res, err := svc.Schema(ctx, &collectionsv1.QuerySchemaRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Modules, 1)
+	require.Equal(t, "test", res.Modules[0].Module)
+	require.Len(t, res.Modules[0].Collections, 2)
+	require.Equal(t, "counters", res.Modules[0].Collections[0].Name)
+	require.Equal(t, "names", res.Modules[0].Collections[1].Name)
+	require.Equal(t, []byte{0}, res.Modules[0].Collections[1].Prefix)
+
+	_, err = svc.Schema(ctx, &collectionsv1.QuerySchemaRequest{Module: "other"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCollectionsQueryServiceGet(t *testing.T) {
+	svc, ctx := setupCollectionsQueryService(t, 3)
+
+	res, err := svc.Get(ctx, &collectionsv1.QueryGetRequest{Module: "test", Collection: "names", Key: `"key01"`})
+	require.NoError(t, err)
+	require.Equal(t, `"value01"`, res.Value)
+
+	_, err = svc.Get(ctx, &collectionsv1.QueryGetRequest{Module: "test", Collection: "names", Key: `"unknown"`})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.Get(ctx, &collectionsv1.QueryGetRequest{Module: "test", Collection: "names", Key: `key01`})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.Get(ctx, &collectionsv1.QueryGetRequest{Module: "test", Collection: "unknown", Key: `"key01"`})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCollectionsQueryServiceEntries(t *testing.T) {
+	const entries = 10
+	svc, ctx := setupCollectionsQueryService(t, entries)
+
+	walk := func(reverse bool, limit uint64) []string {
+		var (
+			keys    []string
+			pageKey []byte
+		)
+		for {
+			res, err := svc.Entries(ctx, &collectionsv1.QueryEntriesRequest{
+				Module:     "test",
+				Collection: "names",
+				Pagination: &queryv1beta1.PageRequest{Key: pageKey, Limit: limit, Reverse: reverse},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(res.Entries)), limit)
+			for _, entry := range res.Entries {
+				keys = append(keys, entry.Key)
+			}
+			if res.Pagination.NextKey == nil {
+				return keys
+			}
+			pageKey = res.Pagination.NextKey
+		}
+	}
+
+	var expected []string
+	for i := 0; i < entries; i++ {
+		expected = append(expected, fmt.Sprintf(`"key%02d"`, i))
+	}
+	require.Equal(t, expected, walk(false, 3))
+	require.Equal(t, expected, walk(false, entries))
+
+	reversed := make([]string, 0, entries)
+	for i := entries - 1; i >= 0; i-- {
+		reversed = append(reversed, expected[i])
+	}
+	require.Equal(t, reversed, walk(true, 3))
+	require.Equal(t, reversed, walk(true, entries))
+
+	// the next key of a page is the raw key of the first entry of the next page
+	res, err := svc.Entries(ctx, &collectionsv1.QueryEntriesRequest{
+		Module:     "test",
+		Collection: "names",
+		Pagination: &queryv1beta1.PageRequest{Limit: 2, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `"value09"`, res.Entries[0].Value)
+	require.Equal(t, []byte("key07"), res.Pagination.NextKey)
+
+	// empty collections have no entries
+	res, err = svc.Entries(ctx, &collectionsv1.QueryEntriesRequest{Module: "test", Collection: "counters"})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+	require.Nil(t, res.Pagination.NextKey)
+
+	_, err = svc.Entries(ctx, &collectionsv1.QueryEntriesRequest{
+		Module:     "test",
+		Collection: "names",
+		Pagination: &queryv1beta1.PageRequest{Offset: 1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCollectionsQueryServiceEntriesLimit(t *testing.T) {
+	svc, ctx := setupCollectionsQueryService(t, 1001)
+
+	// the pagination limit is capped
+	res, err := svc.Entries(ctx, &collectionsv1.QueryEntriesRequest{
+		Module:     "test",
+		Collection: "names",
+		Pagination: &queryv1beta1.PageRequest{Limit: 5000},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1000)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// the default limit is used without pagination
+	res, err = svc.Entries(ctx, &collectionsv1.QueryEntriesRequest{Module: "test", Collection: "names"})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 100)
+}
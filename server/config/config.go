@@ -43,6 +43,14 @@ type BaseConfig struct {
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningBackground removes the pruned heights from disk in the background instead of
+	// during Commit.
+	PruningBackground bool `mapstructure:"pruning-background"`
+
+	// PruningRateLimit is the maximum number of store versions removed per second by
+	// background pruning, 0 for no limit.
+	PruningRateLimit uint64 `mapstructure:"pruning-rate-limit"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -88,6 +96,17 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// OptimisticExecutionWorkers defines the number of workers executing the
+	// transactions of a block optimistically in parallel. Zero disables it.
+	OptimisticExecutionWorkers int `mapstructure:"optimistic-execution-workers"`
+
+	// TraceAccessSets records the keys read and written by each delivered transaction.
+	TraceAccessSets bool `mapstructure:"trace-access-sets"`
+
+	// GasProfiling breaks the gas consumed by the KV store operations of the
+	// transactions down by store, operation and message type.
+	GasProfiling bool `mapstructure:"gas-profiling"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -156,6 +175,17 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotMaxDeltas sets the number of delta snapshots taken on top of a full
+	// snapshot before taking a new full snapshot. 0 disables delta snapshots.
+	SnapshotMaxDeltas uint32 `mapstructure:"snapshot-max-deltas"`
+}
+
+// ArchiveConfig defines the state archive configuration.
+type ArchiveConfig struct {
+	// Enable defines if the state archive, serving the queries at the heights pruned
+	// from the IAVL stores, should be enabled.
+	Enable bool `mapstructure:"enable"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -166,13 +196,35 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int
+
+	// MaxTxsPerSender defines the maximum amount of txs a single sender may have
+	// in the mempool. Zero indicates no cap.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// MaxBytes defines the maximum total size, in bytes, of the txs in the
+	// mempool. Zero indicates no cap.
+	MaxBytes int64 `mapstructure:"max-bytes"`
+
+	// TTLNumBlocks defines the number of blocks a tx may stay in the mempool.
+	// Zero indicates that txs never expire.
+	TTLNumBlocks int64 `mapstructure:"ttl-num-blocks"`
+}
+
+// UsePriorityMempool returns true when any of the limits only supported by the
+// priority nonce mempool is set.
+func (c MempoolConfig) UsePriorityMempool() bool {
+	return c.MaxTxsPerSender > 0 || c.MaxBytes > 0 || c.TTLNumBlocks > 0
 }
 
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		Resume            bool               `mapstructure:"resume"`
+		MaxBufferedBlocks int64              `mapstructure:"max-buffered-blocks"`
+		ABCI              ABCIListenerConfig `mapstructure:"abci"`
+		File              FileListenerConfig `mapstructure:"file"`
+		Sink              SinkListenerConfig `mapstructure:"sink"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +232,26 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the in-process listener writing
+	// the streamed blocks to local files
+	FileListenerConfig struct {
+		Enable        bool     `mapstructure:"enable"`
+		Keys          []string `mapstructure:"keys"`
+		Dir           string   `mapstructure:"dir"`
+		Prefix        string   `mapstructure:"prefix"`
+		MaxFileSize   int64    `mapstructure:"max-file-size"`
+		Fsync         string   `mapstructure:"fsync"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
+	// SinkListenerConfig defines application configuration for the in-process listener sending
+	// the streamed blocks to a registered sink, such as a message broker producer
+	SinkListenerConfig struct {
+		Name          string            `mapstructure:"name"`
+		Keys          []string          `mapstructure:"keys"`
+		TopicPrefix   string            `mapstructure:"topic-prefix"`
+		StopNodeOnErr bool              `mapstructure:"stop-node-on-err"`
+		Options       map[string]string `mapstructure:"options"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -193,6 +265,7 @@ type Config struct {
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
+	Archive   ArchiveConfig    `mapstructure:"archive"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
 }
 
@@ -238,6 +311,8 @@ func DefaultConfig() *Config {
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
 			AppDBBackend:        "",
+
+			OptimisticExecutionWorkers: 0,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -265,10 +340,26 @@ func DefaultConfig() *Config {
 			SnapshotKeepRecent: 2,
 		},
 		Streaming: StreamingConfig{
+			Resume:            true,
+			MaxBufferedBlocks: 10_000,
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Keys:          []string{"*"},
+				Dir:           "data/streaming",
+				Prefix:        "block",
+				MaxFileSize:   100 << 20,
+				Fsync:         "commit",
+				StopNodeOnErr: true,
+			},
+			Sink: SinkListenerConfig{
+				Keys:          []string{"*"},
+				TopicPrefix:   "cosmos",
+				StopNodeOnErr: true,
+				Options:       map[string]string{},
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
@@ -39,11 +39,29 @@ func TestIndexEventsMarshalling(t *testing.T) {
 func TestStreamingConfig(t *testing.T) {
 	cfg := Config{
 		Streaming: StreamingConfig{
+			Resume:            true,
+			MaxBufferedBlocks: 50,
 			ABCI: ABCIListenerConfig{
 				Keys:          []string{"one", "two"},
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Enable:        true,
+				Keys:          []string{"three"},
+				Dir:           "/var/streaming",
+				Prefix:        "node",
+				MaxFileSize:   1024,
+				Fsync:         "always",
+				StopNodeOnErr: true,
+			},
+			Sink: SinkListenerConfig{
+				Name:          "kafka",
+				Keys:          []string{"*"},
+				TopicPrefix:   "chain",
+				StopNodeOnErr: true,
+				Options:       map[string]string{"brokers": "localhost:9092", "acks": "all"},
+			},
 		},
 	}
 
@@ -60,6 +78,16 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`enable = true`,
+		`keys = ["three", ]`,
+		`dir = "/var/streaming"`,
+		`max-file-size = 1024`,
+		`fsync = "always"`,
+		`name = "kafka"`,
+		`topic-prefix = "chain"`,
+		`brokers = "localhost:9092"`,
+		`resume = true`,
+		`max-buffered-blocks = 50`,
 	}
 
 	for _, line := range expectedLines {
@@ -185,6 +213,31 @@ func TestGlobalLabelsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestMempoolWriteRead(t *testing.T) {
+	conf := DefaultConfig()
+	require.False(t, conf.Mempool.UsePriorityMempool())
+
+	conf.Mempool.MaxTxsPerSender = 10
+	conf.Mempool.MaxBytes = 1 << 20
+	conf.Mempool.TTLNumBlocks = 5
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+	require.Equal(t, int64(10), vpr.GetInt64("mempool.max-txs-per-sender"))
+	require.Equal(t, int64(1<<20), vpr.GetInt64("mempool.max-bytes"))
+	require.Equal(t, int64(5), vpr.GetInt64("mempool.ttl-num-blocks"))
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, conf.Mempool.MaxTxsPerSender, cfg.Mempool.MaxTxsPerSender)
+	require.Equal(t, conf.Mempool.MaxBytes, cfg.Mempool.MaxBytes)
+	require.Equal(t, conf.Mempool.TTLNumBlocks, cfg.Mempool.TTLNumBlocks)
+	require.True(t, cfg.Mempool.UsePriorityMempool())
+}
+
 func TestSetConfigTemplate(t *testing.T) {
 	conf := DefaultConfig()
 	var initBuffer, setBuffer bytes.Buffer
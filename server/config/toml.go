@@ -31,6 +31,15 @@ pruning = "{{ .BaseConfig.Pruning }}"
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningBackground removes the pruned heights from disk in a background goroutine instead of
+# during Commit. The heights being removed can't be queried nor snapshotted, and are resumed on
+# restart.
+pruning-background = {{ .BaseConfig.PruningBackground }}
+
+# PruningRateLimit is the maximum number of store versions removed per second by background
+# pruning, to bound its disk usage. 0 disables the limit.
+pruning-rate-limit = {{ .BaseConfig.PruningRateLimit }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -81,6 +90,28 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# OptimisticExecutionWorkers defines the number of workers executing the
+# transactions of a block optimistically in parallel, before they are delivered.
+# Transactions which conflict with the ones before them are re-executed, the
+# results are identical to a sequential execution. Zero disables it.
+# Default is 0.
+optimistic-execution-workers = {{ .BaseConfig.OptimisticExecutionWorkers }}
+
+# TraceAccessSets records the keys read and written, per store, by each delivered
+# transaction. The access sets of the transactions of the last committed block are
+# returned by the "/app/access_set" ABCI query, with the tx hash as data, e.g. to
+# analyze the conflicts between transactions.
+# Default is false.
+trace-access-sets = {{ .BaseConfig.TraceAccessSets }}
+
+# GasProfiling breaks the gas consumed by the KV store operations of the transactions
+# down by store, operation (read, write, has, delete or iter) and message type. The
+# profiles of the delivered transactions are emitted as the "tx_gas_profile" telemetry
+# counter, and the profiles of the simulated transactions are returned in the
+# "gas_profile" field of their gas info.
+# Default is false.
+gas-profiling = {{ .BaseConfig.GasProfiling }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -198,6 +229,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-max-deltas specifies the number of delta snapshots, only containing the state changed
+# since the previous snapshot, taken between two full snapshots (0 to disable). Delta snapshots
+# are not served to state sync peers, but can be restored locally on top of their base snapshots.
+snapshot-max-deltas = {{ .StateSync.SnapshotMaxDeltas }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -205,6 +241,16 @@ snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 # Streaming allows nodes to stream state to external systems.
 [streaming]
 
+# resume defines whether the file and sink listeners persist the height of the last block they
+# delivered, so the change sets of the blocks they missed, after an error or a restart, are
+# replayed to them from a local buffer. The buffer also lets the state archive catch up on the
+# blocks it missed instead of being seeded again.
+resume = {{ .Streaming.Resume }}
+
+# max-buffered-blocks is the maximum number of recent blocks whose change sets are kept in the
+# buffer while they are not delivered to all the listeners (0 for no limit).
+max-buffered-blocks = {{ .Streaming.MaxBufferedBlocks }}
+
 # streaming.abci specifies the configuration for the ABCI Listener streaming service.
 [streaming.abci]
 
@@ -224,6 +270,68 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the in-process listener appending the streamed
+# blocks to local files, as length-prefixed protobuf records.
+[streaming.file]
+
+# enable defines if the file listener should be enabled.
+enable = {{ .Streaming.File.Enable }}
+
+# List of kv store keys whose changes are written, ["*"] to expose all keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# dir is the directory the files are written to, relative to the node home if not absolute.
+dir = "{{ .Streaming.File.Dir }}"
+
+# prefix is the prefix of the file names, followed by the height of the first block of the file.
+prefix = "{{ .Streaming.File.Prefix }}"
+
+# max-file-size is the size in bytes after which a new file is started, at the next block.
+max-file-size = {{ .Streaming.File.MaxFileSize }}
+
+# fsync defines when the files are synced to disk: "none" leaves it to the operating system,
+# "commit" syncs once all the records of a block are written and "always" syncs after each record.
+fsync = "{{ .Streaming.File.Fsync }}"
+
+# stop-node-on-err specifies whether to stop the node when the blocks can't be written.
+stop-node-on-err = {{ .Streaming.File.StopNodeOnErr }}
+
+# streaming.sink specifies the configuration for the in-process listener sending the streamed
+# blocks to a sink, such as a Kafka producer, registered by the application.
+[streaming.sink]
+
+# name is the name the sink is registered under. The sink listener is only enabled if this is set.
+name = "{{ .Streaming.Sink.Name }}"
+
+# List of kv store keys whose changes are sent, ["*"] to expose all keys.
+keys = [{{ range .Streaming.Sink.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# topic-prefix is the prefix of the topics the records are sent to, such as <prefix>.store_kv_pair.
+topic-prefix = "{{ .Streaming.Sink.TopicPrefix }}"
+
+# stop-node-on-err specifies whether to stop the node when the blocks can't be sent.
+stop-node-on-err = {{ .Streaming.Sink.StopNodeOnErr }}
+
+# streaming.sink.options are passed as is to the constructor of the sink.
+[streaming.sink.options]
+{{- range $key, $value := .Streaming.Sink.Options }}
+{{ $key }} = "{{ $value }}"
+{{- end }}
+
+###############################################################################
+###                              State Archive                              ###
+###############################################################################
+
+# The state archive keeps the history of the state in a flat versioned layout, fed by the change
+# sets of the committed blocks, to serve the queries at the heights pruned from the IAVL stores.
+# Archive nodes can then use an aggressive pruning strategy instead of "nothing". The archive is
+# seeded with the current state when enabled on an existing node, and only serves the heights
+# from then on. Queries with proofs are not supported at archived heights.
+[archive]
+
+# enable defines if the state archive should be enabled.
+enable = {{ .Archive.Enable }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -236,6 +344,18 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+# The following limits are only supported by the priority nonce mempool, which
+# replaces the default sender nonce mempool as soon as any of them is set.
+#
+# max-txs-per-sender limits the number of transactions a single sender can have in the mempool (0 = unlimited).
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# max-bytes limits the total size, in bytes, of the transactions in the mempool (0 = unlimited).
+max-bytes = {{ .Mempool.MaxBytes }}
+
+# ttl-num-blocks sets the number of blocks a transaction can stay in the mempool (0 = never expire).
+ttl-num-blocks = {{ .Mempool.TTLNumBlocks }}
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,376 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	cmtdbm "github.com/cometbft/cometbft-db"
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagReplayFrom = "from"
+	flagReplayTo   = "to"
+)
+
+// NewReplayCmd creates a command to re-execute blocks from the local CometBFT
+// block store against the application state, without any networking.
+func NewReplayCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-execute blocks from the local block store and compare the resulting app hashes",
+		Long: `
+Replay loads the application state at height --from and re-executes the blocks
+--from + 1 through --to, read from the local CometBFT block store, through the
+application. After every block the resulting app hash is compared with the app
+hash CometBFT recorded for it, and replay stops at the first mismatch.
+
+The application database is never modified: all writes are kept in memory and
+discarded once the command exits. The node must be stopped while replaying.
+`,
+		Example: fmt.Sprintf("$ %s debug replay --from 100 --to 200", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := cmd.Flags().GetInt64(flagReplayFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagReplayTo)
+			if err != nil {
+				return err
+			}
+			if from <= 0 || to <= from {
+				return fmt.Errorf("invalid replay range: --from %d --to %d", from, to)
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			cfg := ctx.Config
+
+			blockStore, stateStore, err := openCometStores(cfg.DBDir(), cmtdbm.BackendType(cfg.DBBackend))
+			if err != nil {
+				return err
+			}
+			defer blockStore.Close()
+			defer stateStore.Close()
+
+			if to > blockStore.Height() {
+				return fmt.Errorf("block %d is not in the block store, latest block is %d", to, blockStore.Height())
+			}
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(cfg.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// Disable everything which would either write outside of the
+			// application database or conflict with reloading an older version.
+			ctx.Viper.Set(FlagInterBlockCache, false)
+			ctx.Viper.Set(FlagPruning, "nothing")
+			ctx.Viper.Set(FlagStateSyncSnapshotInterval, 0)
+			ctx.Viper.Set(FlagHaltHeight, 0)
+			ctx.Viper.Set(FlagHaltTime, 0)
+
+			app := appCreator(ctx.Logger, newOverlayDB(db), nil, ctx.Viper)
+			if err := app.CommitMultiStore().LoadVersion(from); err != nil {
+				return fmt.Errorf("failed to load version %d: %w", from, err)
+			}
+
+			proxyApp := proxy.NewAppConnConsensus(abcicli.NewLocalClient(nil, app), proxy.NopMetrics())
+			logger := servercmtlog.CometZeroLogWrapper{Logger: ctx.Logger}
+
+			for height := from + 1; height <= to; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d is not in the block store", height)
+				}
+
+				appHash, err := execCommitBlock(proxyApp, block, logger, stateStore, state.InitialHeight)
+				if err != nil {
+					return fmt.Errorf("failed to replay block %d: %w", height, err)
+				}
+
+				// the app hash resulting from a block is only recorded in the
+				// header of the block which follows it
+				var expected []byte
+				switch next := blockStore.LoadBlockMeta(height + 1); {
+				case next != nil:
+					expected = next.Header.AppHash
+				case height == state.LastBlockHeight:
+					expected = state.AppHash
+				default:
+					cmd.Printf("height %d: app hash %X (unverified)\n", height, appHash)
+					continue
+				}
+
+				if !bytes.Equal(appHash, expected) {
+					return fmt.Errorf("app hash mismatch at height %d: got %X, expected %X", height, appHash, expected)
+				}
+
+				cmd.Printf("height %d: app hash %X\n", height, appHash)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagReplayFrom, 0, "Height of the application state to start replaying from")
+	cmd.Flags().Int64(flagReplayTo, 0, "Height of the last block to replay")
+
+	return cmd
+}
+
+// execCommitBlock executes and commits a block, converting a panic while doing
+// so into an error. Committing a block to a version which already exists in the
+// application database panics if the resulting state differs from it.
+func execCommitBlock(
+	proxyApp proxy.AppConnConsensus, block *cmttypes.Block, logger cmtlog.Logger, stateStore sm.Store, initialHeight int64,
+) (appHash []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return sm.ExecCommitBlock(proxyApp, block, logger, stateStore, initialHeight)
+}
+
+// openCometStores opens the CometBFT block and state stores in the given
+// directory.
+func openCometStores(dir string, backend cmtdbm.BackendType) (*cmtstore.BlockStore, sm.Store, error) {
+	if !cmtos.FileExists(filepath.Join(dir, "blockstore.db")) {
+		return nil, nil, fmt.Errorf("no blockstore found in %v", dir)
+	}
+
+	blockStoreDB, err := cmtdbm.NewDB("blockstore", backend, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !cmtos.FileExists(filepath.Join(dir, "state.db")) {
+		return nil, nil, fmt.Errorf("no statestore found in %v", dir)
+	}
+
+	stateDB, err := cmtdbm.NewDB("state", backend, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cmtstore.NewBlockStore(blockStoreDB), sm.NewStore(stateDB, sm.StoreOptions{}), nil
+}
+
+var (
+	_ dbm.DB    = (*overlayDB)(nil)
+	_ dbm.Batch = (*overlayBatch)(nil)
+
+	errOverlayKeyEmpty    = errors.New("key cannot be empty")
+	errOverlayValueNil    = errors.New("value cannot be nil")
+	errOverlayBatchClosed = errors.New("batch has been written or closed")
+)
+
+// overlayDB is a dbm.DB which reads through to a parent database but keeps all
+// writes in memory, such that the parent database is never modified.
+type overlayDB struct {
+	store *cachekv.Store
+}
+
+func newOverlayDB(parent dbm.DB) *overlayDB {
+	return &overlayDB{store: cachekv.NewStore(dbadapter.Store{DB: parent})}
+}
+
+// Get implements DB.
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errOverlayKeyEmpty
+	}
+	return db.store.Get(key), nil
+}
+
+// Has implements DB.
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, errOverlayKeyEmpty
+	}
+	return db.store.Has(key), nil
+}
+
+// Set implements DB.
+func (db *overlayDB) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	if value == nil {
+		return errOverlayValueNil
+	}
+	db.store.Set(bytes.Clone(key), bytes.Clone(value))
+	return nil
+}
+
+// SetSync implements DB.
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+// Delete implements DB.
+func (db *overlayDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	db.store.Delete(bytes.Clone(key))
+	return nil
+}
+
+// DeleteSync implements DB.
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+// Iterator implements DB.
+func (db *overlayDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errOverlayKeyEmpty
+	}
+	return db.store.Iterator(start, end), nil
+}
+
+// ReverseIterator implements DB.
+func (db *overlayDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errOverlayKeyEmpty
+	}
+	return db.store.ReverseIterator(start, end), nil
+}
+
+// Close implements DB. The parent database is left open.
+func (db *overlayDB) Close() error {
+	return nil
+}
+
+// NewBatch implements DB.
+func (db *overlayDB) NewBatch() dbm.Batch {
+	return &overlayBatch{db: db}
+}
+
+// NewBatchWithSize implements DB.
+func (db *overlayDB) NewBatchWithSize(size int) dbm.Batch {
+	return &overlayBatch{db: db, ops: make([]overlayOp, 0, size)}
+}
+
+// Print implements DB.
+func (db *overlayDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return nil
+}
+
+// Stats implements DB.
+func (db *overlayDB) Stats() map[string]string {
+	return map[string]string{"database.type": "overlayDB"}
+}
+
+// overlayOp is a single batched write, or a deletion if value is nil.
+type overlayOp struct {
+	key, value []byte
+}
+
+// overlayBatch is a batch of writes to an overlayDB.
+type overlayBatch struct {
+	db   *overlayDB
+	ops  []overlayOp
+	size int
+}
+
+// Set implements Batch.
+func (b *overlayBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	if value == nil {
+		return errOverlayValueNil
+	}
+	if b.db == nil {
+		return errOverlayBatchClosed
+	}
+	b.size += len(key) + len(value)
+	b.ops = append(b.ops, overlayOp{key: bytes.Clone(key), value: bytes.Clone(value)})
+	return nil
+}
+
+// Delete implements Batch.
+func (b *overlayBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errOverlayKeyEmpty
+	}
+	if b.db == nil {
+		return errOverlayBatchClosed
+	}
+	b.size += len(key)
+	b.ops = append(b.ops, overlayOp{key: bytes.Clone(key)})
+	return nil
+}
+
+// Write implements Batch.
+func (b *overlayBatch) Write() error {
+	if b.db == nil {
+		return errOverlayBatchClosed
+	}
+
+	for _, op := range b.ops {
+		if op.value == nil {
+			b.db.store.Delete(op.key)
+		} else {
+			b.db.store.Set(op.key, op.value)
+		}
+	}
+
+	return b.Close()
+}
+
+// WriteSync implements Batch.
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+// Close implements Batch.
+func (b *overlayBatch) Close() error {
+	b.db = nil
+	b.ops = nil
+	return nil
+}
+
+// GetByteSize implements Batch.
+func (b *overlayBatch) GetByteSize() (int, error) {
+	if b.db == nil {
+		return 0, errOverlayBatchClosed
+	}
+	return b.size, nil
+}
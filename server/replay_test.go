@@ -0,0 +1,243 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmtdbm "github.com/cometbft/cometbft-db"
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOverlayDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+	require.NoError(t, parent.Set([]byte("b"), []byte("2")))
+	require.NoError(t, parent.Set([]byte("c"), []byte("3")))
+
+	db := newOverlayDB(parent)
+	require.NoError(t, db.Set([]byte("a"), []byte("10")))
+	require.NoError(t, db.Delete([]byte("b")))
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("d"), []byte("4")))
+	require.NoError(t, batch.Delete([]byte("c")))
+	require.NoError(t, batch.Write())
+	require.ErrorIs(t, batch.Set([]byte("e"), []byte("5")), errOverlayBatchClosed)
+
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("10"), value)
+
+	has, err := db.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, []string{"a", "d"}, keys)
+
+	_, err = db.Get(nil)
+	require.ErrorIs(t, err, errOverlayKeyEmpty)
+	require.ErrorIs(t, db.Set([]byte("a"), nil), errOverlayValueNil)
+
+	// the parent database is left untouched
+	itr, err = parent.Iterator(nil, nil)
+	require.NoError(t, err)
+	keys = nil
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, []string{"a", "b", "c"}, keys)
+
+	value, err = parent.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+}
+
+// replayTestApp is a minimal application recording the height of every block
+// it executes in its store.
+type replayTestApp struct {
+	*baseapp.BaseApp
+}
+
+func newReplayTestApp(logger log.Logger, db dbm.DB, _ io.Writer, _ types.AppOptions) types.Application {
+	key := storetypes.NewKVStoreKey("replay")
+
+	app := baseapp.NewBaseApp("replay", logger, db, nil, baseapp.SetChainID("replay"))
+	app.MountStores(key)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) (abci.ResponseBeginBlock, error) {
+		ctx.KVStore(key).Set([]byte("height"), sdk.Uint64ToBigEndian(uint64(req.Header.Height)))
+		return abci.ResponseBeginBlock{}, nil
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return replayTestApp{app}
+}
+
+func (replayTestApp) RegisterAPIRoutes(*api.Server, config.APIConfig) {}
+func (replayTestApp) RegisterTxService(client.Context)                {}
+func (replayTestApp) RegisterTendermintService(client.Context)        {}
+func (replayTestApp) RegisterNodeService(client.Context)              {}
+
+// makeReplayTestChain executes the given number of empty blocks through the
+// application and saves them, along with the resulting states, in the block
+// and state stores of the node. It returns the app hash after every height.
+func makeReplayTestChain(t *testing.T, cfg *cmtcfg.Config, blocks int64) map[int64][]byte {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(cfg.DBDir(), 0o700))
+	blockStoreDB, err := cmtdbm.NewDB("blockstore", cmtdbm.GoLevelDBBackend, cfg.DBDir())
+	require.NoError(t, err)
+	stateDB, err := cmtdbm.NewDB("state", cmtdbm.GoLevelDBBackend, cfg.DBDir())
+	require.NoError(t, err)
+	blockStore, stateStore := cmtstore.NewBlockStore(blockStoreDB), sm.NewStore(stateDB, sm.StoreOptions{})
+	defer blockStore.Close()
+	defer stateStore.Close()
+
+	db, err := openDB(cfg.RootDir, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	defer db.Close()
+
+	app := newReplayTestApp(log.NewNopLogger(), db, nil, nil)
+	app.InitChain(abci.RequestInitChain{ChainId: "replay", InitialHeight: 1})
+	proxyApp := proxy.NewAppConnConsensus(abcicli.NewLocalClient(nil, app), proxy.NopMetrics())
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	state, err := sm.MakeGenesisState(&cmttypes.GenesisDoc{
+		ChainID:         "replay",
+		GenesisTime:     time.Now(),
+		InitialHeight:   1,
+		ConsensusParams: cmttypes.DefaultConsensusParams(),
+		Validators:      []cmttypes.GenesisValidator{{Address: pubKey.Address(), PubKey: pubKey, Power: 10}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+
+	appHashes := make(map[int64][]byte)
+	lastCommit := &cmttypes.Commit{}
+	for height := int64(1); height <= blocks; height++ {
+		block := state.MakeBlock(height, nil, lastCommit, nil, pubKey.Address())
+
+		appHash, err := sm.ExecCommitBlock(proxyApp, block, cmtlog.NewNopLogger(), stateStore, state.InitialHeight)
+		require.NoError(t, err)
+		appHashes[height] = appHash
+
+		partSet, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := cmttypes.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		// the validator never signs, the blocks are only executed and not verified
+		lastCommit = &cmttypes.Commit{Height: height, BlockID: blockID, Signatures: []cmttypes.CommitSig{cmttypes.NewCommitSigAbsent()}}
+		blockStore.SaveBlock(block, partSet, lastCommit)
+
+		state.LastBlockHeight = height
+		state.LastBlockID = blockID
+		state.LastValidators = state.Validators.Copy()
+		state.AppHash = appHash
+		require.NoError(t, stateStore.Save(state))
+	}
+
+	return appHashes
+}
+
+// readReplayTestDB returns all the entries of the application database.
+func readReplayTestDB(t *testing.T, rootDir string) map[string]string {
+	t.Helper()
+
+	db, err := openDB(rootDir, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	defer db.Close()
+
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer itr.Close()
+
+	entries := make(map[string]string)
+	for ; itr.Valid(); itr.Next() {
+		entries[string(itr.Key())] = string(itr.Value())
+	}
+
+	return entries
+}
+
+func TestReplayCmd(t *testing.T) {
+	cfg := cmtcfg.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+	appHashes := makeReplayTestChain(t, cfg, 5)
+	entries := readReplayTestDB(t, cfg.RootDir)
+
+	replay := func(args ...string) (string, error) {
+		ctx := context.WithValue(context.Background(), ServerContextKey, NewContext(viper.New(), cfg, log.NewNopLogger()))
+		cmd := NewReplayCmd(newReplayTestApp, cfg.RootDir)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs(args)
+
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	// the blocks are re-executed from the block store and their app hashes
+	// compared with the ones recorded by the following blocks and the state
+	out, err := replay("--from", "2", "--to", "5")
+	require.NoError(t, err)
+	for height := int64(3); height <= 5; height++ {
+		require.Contains(t, out, fmt.Sprintf("height %d: app hash %X\n", height, appHashes[height]))
+	}
+	require.NotContains(t, out, "height 2:")
+	require.Equal(t, entries, readReplayTestDB(t, cfg.RootDir))
+
+	_, err = replay("--from", "2", "--to", "6")
+	require.ErrorContains(t, err, "block 6 is not in the block store, latest block is 5")
+	_, err = replay("--from", "3", "--to", "3")
+	require.ErrorContains(t, err, "invalid replay range")
+
+	// tamper with the app hash recorded for the last block
+	stateDB, err := cmtdbm.NewDB("state", cmtdbm.GoLevelDBBackend, cfg.DBDir())
+	require.NoError(t, err)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	state.AppHash = []byte("tampered")
+	require.NoError(t, stateStore.Save(state))
+	require.NoError(t, stateStore.Close())
+
+	out, err = replay("--from", "2", "--to", "5")
+	require.ErrorContains(t, err, fmt.Sprintf("app hash mismatch at height 5: got %X, expected %X", appHashes[5], []byte("tampered")))
+	require.Contains(t, out, fmt.Sprintf("height 4: app hash %X\n", appHashes[4]))
+	require.Equal(t, entries, readReplayTestDB(t, cfg.RootDir))
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagSnapshotHeight = "height"
+
+// NewSnapshotsCmd creates the command group managing the local state sync snapshots.
+func NewSnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+		Long: `
+Manage the state sync snapshots of the node, stored in the data/snapshots
+directory of its home. Snapshots can be packed into a single archive file, and
+loaded from such an archive, to bootstrap a node without any peer serving the
+snapshot. The node must be stopped while running these commands.
+`,
+	}
+
+	cmd.AddCommand(
+		newSnapshotsListCmd(),
+		newSnapshotsExportCmd(appCreator),
+		newSnapshotsRestoreCmd(appCreator),
+		newSnapshotsDumpCmd(),
+		newSnapshotsLoadCmd(),
+		newSnapshotsDeleteCmd(),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+func newSnapshotsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d base_height: %d\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Metadata.BaseHeight)
+			}
+
+			return nil
+		},
+	}
+}
+
+func newSnapshotsExportCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Take a snapshot of the application state at the latest or given height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			height, err := cmd.Flags().GetInt64(flagSnapshotHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			if app.SnapshotManager() == nil {
+				return fmt.Errorf("snapshots are disabled by the application")
+			}
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+
+			cmd.Printf("Exporting snapshot at height %d\n", height)
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot: %w", err)
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagSnapshotHeight, 0, "Height to export, defaults to the latest height")
+	return cmd
+}
+
+func newSnapshotsRestoreCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the application state from a local snapshot",
+		Long: `
+Restore the application state from a local snapshot, which must be done on an
+empty application database. Delta snapshots are restored on top of their base
+snapshots, which must also be present in the local snapshot store.
+
+Only the application state is restored: the CometBFT state of the node must be
+bootstrapped at the snapshot height separately before starting the node.
+`,
+		Example: fmt.Sprintf("$ %s snapshots restore 1000 3", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			if err := checkEmptyDB(db); err != nil {
+				return err
+			}
+
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			if app.SnapshotManager() == nil {
+				return fmt.Errorf("snapshots are disabled by the application")
+			}
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			cmd.Printf("Restored application state at height %d\n", height)
+			return nil
+		},
+	}
+}
+
+func newSnapshotsDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			return snapshotStore.Delete(height, format)
+		},
+	}
+}
+
+// checkEmptyDB returns an error if the application database holds any data, as
+// snapshots can only be restored on an empty database.
+func checkEmptyDB(db dbm.DB) error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	if itr.Valid() {
+		return errors.New("the application database isn't empty, snapshots can only be restored on an empty database")
+	}
+	return nil
+}
+
+// parseSnapshotArgs parses the height and format arguments identifying a snapshot.
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+
+	return height, uint32(format), nil
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagSnapshotOutput = "output"
+
+	// snapshotArchiveMetadata is the name of the archive entry holding the snapshot metadata,
+	// followed by one entry per chunk named after its index.
+	snapshotArchiveMetadata = "snapshot"
+)
+
+func newSnapshotsDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dump [height] [format]",
+		Short:   "Pack a local snapshot into a single archive file",
+		Example: fmt.Sprintf("$ %s snapshots dump 1000 3 --output snapshot.tar.gz", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagSnapshotOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			if err := dumpSnapshot(snapshotStore, height, format, file); err != nil {
+				_ = file.Close()
+				_ = os.Remove(output)
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d written to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagSnapshotOutput, "o", "", "Archive file to write, defaults to <height>-<format>.tar.gz")
+	return cmd
+}
+
+func newSnapshotsLoadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load [archive-file]",
+		Short: "Load a snapshot archive file into the local snapshot store",
+		Long: `
+Load a snapshot archive file, written by the dump command, into the local
+snapshot store, from which it can be restored or served to state sync peers.
+The chunks are verified against the snapshot hash recorded in the archive.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			snapshotStore, snapshotDB, err := openSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := loadSnapshot(snapshotStore, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d, format %d loaded\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
+
+// dumpSnapshot writes the snapshot at height with the given format as a gzipped tar archive,
+// containing the snapshot metadata followed by its chunks.
+func dumpSnapshot(snapshotStore *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	if err := writeArchiveEntry(tw, snapshotArchiveMetadata, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := snapshotStore.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return fmt.Errorf("chunk %d of the snapshot at height %d, format %d doesn't exist", i, height, format)
+		}
+		bz, err := io.ReadAll(chunk)
+		_ = chunk.Close()
+		if err != nil {
+			return err
+		}
+		if err := writeArchiveEntry(tw, strconv.FormatUint(uint64(i), 10), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, bz []byte) error {
+	header := &tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(bz)
+	return err
+}
+
+// loadSnapshot saves the snapshot of an archive written by dumpSnapshot into the snapshot store,
+// and checks it matches the hash recorded in the archive.
+func loadSnapshot(snapshotStore *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	header, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	if header.Name != snapshotArchiveMetadata {
+		return nil, fmt.Errorf("invalid snapshot archive: expected %q entry, got %q", snapshotArchiveMetadata, header.Name)
+	}
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+	var expected snapshottypes.Snapshot
+	if err := expected.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("invalid snapshot archive metadata: %w", err)
+	}
+
+	// The chunks are streamed from the archive to the store, which hashes them as they are saved.
+	// done stops the stream if the store returns before reading all the chunks.
+	chunks := make(chan io.ReadCloser)
+	chErr := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < expected.Chunks; i++ {
+			header, err := tr.Next()
+			if err != nil {
+				chErr <- fmt.Errorf("failed to read chunk %d from snapshot archive: %w", i, err)
+				return
+			}
+			if header.Name != strconv.FormatUint(uint64(i), 10) {
+				chErr <- fmt.Errorf("invalid snapshot archive: expected chunk %d, got %q", i, header.Name)
+				return
+			}
+			bz, err := io.ReadAll(tr)
+			if err != nil {
+				chErr <- err
+				return
+			}
+			select {
+			case chunks <- io.NopCloser(bytes.NewReader(bz)):
+			case <-done:
+				return
+			}
+		}
+		chErr <- nil
+	}()
+
+	var snapshot *snapshottypes.Snapshot
+	if expected.Metadata.BaseHeight > 0 {
+		snapshot, err = snapshotStore.SaveDelta(expected.Height, expected.Metadata.BaseHeight, chunks)
+	} else {
+		snapshot, err = snapshotStore.Save(expected.Height, expected.Format, chunks)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = <-chErr
+	if err == nil && !bytes.Equal(snapshot.Hash, expected.Hash) {
+		err = errors.New("snapshot hash doesn't match the archive metadata")
+	}
+	if err != nil {
+		if delErr := snapshotStore.Delete(snapshot.Height, snapshot.Format); delErr != nil {
+			return nil, errors.Join(err, delErr)
+		}
+		return nil, err
+	}
+
+	return snapshot, nil
+}
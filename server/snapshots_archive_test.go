@@ -0,0 +1,124 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSnapshotStore(t *testing.T) *snapshots.Store {
+	t.Helper()
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func saveTestSnapshot(t *testing.T, store *snapshots.Store, height uint64, chunks ...[]byte) {
+	t.Helper()
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	_, err := store.Save(height, 1, ch)
+	require.NoError(t, err)
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	source := newTestSnapshotStore(t)
+	saveTestSnapshot(t, source, 3, []byte{1, 2, 3}, []byte{4, 5}, []byte{6})
+
+	var archive bytes.Buffer
+	require.NoError(t, dumpSnapshot(source, 3, 1, &archive))
+	require.Error(t, dumpSnapshot(source, 4, 1, io.Discard))
+
+	target := newTestSnapshotStore(t)
+	loaded, err := loadSnapshot(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+
+	expected, err := source.Get(3, 1)
+	require.NoError(t, err)
+	require.Equal(t, expected, loaded)
+
+	for i := uint32(0); i < expected.Chunks; i++ {
+		sourceChunk, err := source.LoadChunk(3, 1, i)
+		require.NoError(t, err)
+		targetChunk, err := target.LoadChunk(3, 1, i)
+		require.NoError(t, err)
+
+		sourceBz, err := io.ReadAll(sourceChunk)
+		require.NoError(t, err)
+		targetBz, err := io.ReadAll(targetChunk)
+		require.NoError(t, err)
+		require.Equal(t, sourceBz, targetBz)
+		require.NoError(t, sourceChunk.Close())
+		require.NoError(t, targetChunk.Close())
+	}
+
+	// loading the same snapshot twice fails
+	_, err = loadSnapshot(target, bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+}
+
+func TestSnapshotArchive_Invalid(t *testing.T) {
+	// the chunks of the archive don't match the snapshot hash
+	source := newTestSnapshotStore(t)
+	saveTestSnapshot(t, source, 3, []byte{1, 2, 3})
+	saveTestSnapshot(t, source, 4, []byte{1, 2, 4})
+
+	var archive bytes.Buffer
+	require.NoError(t, dumpSnapshot(source, 4, 1, &archive))
+	other, err := source.Get(3, 1)
+	require.NoError(t, err)
+	other.Height = 4
+	tampered := replaceArchiveMetadata(t, archive.Bytes(), other)
+
+	target := newTestSnapshotStore(t)
+	_, err = loadSnapshot(target, bytes.NewReader(tampered))
+	require.ErrorContains(t, err, "snapshot hash doesn't match")
+
+	snapshot, err := target.Get(4, 1)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	// not an archive
+	_, err = loadSnapshot(target, bytes.NewReader([]byte("invalid")))
+	require.Error(t, err)
+}
+
+// replaceArchiveMetadata rewrites the archive with the metadata of another snapshot.
+func replaceArchiveMetadata(t *testing.T, archive []byte, snapshot *snapshottypes.Snapshot) []byte {
+	t.Helper()
+	gr, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+
+	var out bytes.Buffer
+	gw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gw)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		bz, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if header.Name == snapshotArchiveMetadata {
+			bz, err = snapshot.Marshal()
+			require.NoError(t, err)
+		}
+		require.NoError(t, writeArchiveEntry(tw, header.Name, bz))
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return out.Bytes()
+}
@@ -0,0 +1,16 @@
+package server
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEmptyDB(t *testing.T) {
+	db := dbm.NewMemDB()
+	require.NoError(t, checkEmptyDB(db))
+
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	require.ErrorContains(t, checkEmptyDB(db), "the application database isn't empty")
+}
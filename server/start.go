@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"runtime/pprof"
@@ -53,15 +54,22 @@ const (
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
+	FlagPruningBackground   = "pruning-background"
+	FlagPruningRateLimit    = "pruning-rate-limit"
 	FlagIndexEvents         = "index-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
 
+	FlagOptimisticExecutionWorkers = "optimistic-execution-workers"
+	FlagTraceAccessSets            = "trace-access-sets"
+	FlagGasProfiling               = "gas-profiling"
+
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotMaxDeltas  = "state-sync.snapshot-max-deltas"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -80,7 +88,10 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs          = "mempool.max-txs"
+	FlagMempoolMaxTxsPerSender = "mempool.max-txs-per-sender"
+	FlagMempoolMaxBytes        = "mempool.max-bytes"
+	FlagMempoolTTLNumBlocks    = "mempool.ttl-num-blocks"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -165,6 +176,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(FlagPruningBackground, false, "Remove the pruned heights from disk in the background instead of during Commit")
+	cmd.Flags().Uint64(FlagPruningRateLimit, 0, "Maximum number of store versions removed per second by background pruning (0 for no limit)")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().Bool(FlagAPIEnable, false, "Define if the API server should be enabled")
@@ -181,8 +194,15 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled)")
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotMaxDeltas, 0, "Number of delta snapshots taken between two full state sync snapshots")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the maximum number of txs of a single sender in the app-side mempool (enables the priority nonce mempool)")
+	cmd.Flags().Int64(FlagMempoolMaxBytes, 0, "Sets the maximum total size in bytes of the txs in the app-side mempool (enables the priority nonce mempool)")
+	cmd.Flags().Int64(FlagMempoolTTLNumBlocks, 0, "Sets the number of blocks a tx can stay in the app-side mempool (enables the priority nonce mempool)")
+	cmd.Flags().Int(FlagOptimisticExecutionWorkers, 0, "Number of workers executing the txs of a block optimistically in parallel (0 disables optimistic execution)")
+	cmd.Flags().Bool(FlagTraceAccessSets, false, "Record the keys read and written by each delivered tx, served by the /app/access_set query")
+	cmd.Flags().Bool(FlagGasProfiling, false, "Break the KV store gas of the txs down by store, operation and msg type, in telemetry and simulate responses")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -251,6 +271,7 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 		return svr.Stop()
 	})
 
+	defer closeCommitMultiStore(svrCtx, app)
 	return g.Wait()
 }
 
@@ -453,6 +474,8 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 			_ = tmNode.Stop()
 		}
 
+		closeCommitMultiStore(svrCtx, app)
+
 		if traceWriterCleanup != nil {
 			traceWriterCleanup()
 		}
@@ -462,6 +485,18 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 	return g.Wait()
 }
 
+// closeCommitMultiStore closes the multistore of the application, if it can be
+// closed, stopping its background pruning.
+func closeCommitMultiStore(svrCtx *Context, app types.Application) {
+	closer, ok := app.CommitMultiStore().(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		svrCtx.Logger.Error("failed to close the multistore", "err", err)
+	}
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	if !cfg.Telemetry.Enabled {
 		return nil, nil
@@ -331,6 +331,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		NewSnapshotsCmd(appCreator, defaultNodeHome),
 	)
 }
 
@@ -443,6 +444,37 @@ func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	)
 }
 
+// GetSnapshotStore opens the state sync snapshot store of the node, located in the data
+// directory of the application home. Its metadata database stays open for the lifetime
+// of the application.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotStore, _, err := openSnapshotStore(appOpts)
+	return snapshotStore, err
+}
+
+// openSnapshotStore opens the state sync snapshot store of the node along with its
+// metadata database, which must be closed by the caller.
+func openSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, dbm.DB, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
+		return nil, nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		_ = snapshotDB.Close()
+		return nil, nil, err
+	}
+
+	return snapshotStore, snapshotDB, nil
+}
+
 // DefaultBaseappOptions returns the default baseapp options provided by the Cosmos SDK
 func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache storetypes.MultiStorePersistentCache
@@ -468,16 +500,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		chainID = appGenesis.ChainID
 	}
 
-	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
-	if err = os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
-		panic(fmt.Errorf("failed to create snapshots directory: %w", err))
-	}
-
-	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	snapshotStore, err := GetSnapshotStore(appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -486,6 +509,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval)),
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
+	snapshotOptions.MaxDeltas = cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotMaxDeltas))
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
@@ -499,12 +523,39 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
-		baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))),
-			),
-		),
+		baseapp.SetMempool(newMempool(appOpts)),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
+		baseapp.SetBackgroundPruning(
+			cast.ToBool(appOpts.Get(FlagPruningBackground)),
+			cast.ToUint64(appOpts.Get(FlagPruningRateLimit)),
+		),
+		baseapp.SetOptimisticExecution(cast.ToInt(appOpts.Get(FlagOptimisticExecutionWorkers))),
+		baseapp.SetAccessSetTracing(cast.ToBool(appOpts.Get(FlagTraceAccessSets))),
+		baseapp.SetGasProfiling(cast.ToBool(appOpts.Get(FlagGasProfiling))),
 		baseapp.SetChainID(chainID),
 	}
 }
+
+// newMempool returns the app-side mempool configured by the given options: the
+// priority nonce mempool when any of the limits only it supports is set, the
+// sender nonce mempool otherwise.
+func newMempool(appOpts types.AppOptions) mempool.Mempool {
+	cfg := config.MempoolConfig{
+		MaxTxs:          cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)),
+		MaxTxsPerSender: cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender)),
+		MaxBytes:        cast.ToInt64(appOpts.Get(FlagMempoolMaxBytes)),
+		TTLNumBlocks:    cast.ToInt64(appOpts.Get(FlagMempoolTTLNumBlocks)),
+	}
+
+	if !cfg.UsePriorityMempool() {
+		return mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(cfg.MaxTxs))
+	}
+
+	mpCfg := mempool.DefaultPriorityNonceMempoolConfig()
+	mpCfg.MaxTx = cfg.MaxTxs
+	mpCfg.MaxTxPerSender = cfg.MaxTxsPerSender
+	mpCfg.MaxBytes = cfg.MaxBytes
+	mpCfg.TTLNumBlocks = cfg.TTLNumBlocks
+
+	return mempool.NewPriorityMempool(mpCfg)
+}
@@ -12,6 +12,7 @@ import (
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -83,6 +84,7 @@ func (app SimApp) RegisterUpgradeHandlers() {
 			Added: []string{
 				consensustypes.ModuleName,
 				crisistypes.ModuleName,
+				feemarkettypes.ModuleName,
 			},
 		}
 
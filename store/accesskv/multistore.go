@@ -0,0 +1,84 @@
+package accesskv
+
+import (
+	"io"
+
+	"cosmossdk.io/store/types"
+)
+
+var (
+	_ types.MultiStore      = MultiStore{}
+	_ types.CacheMultiStore = cacheMultiStore{}
+)
+
+// MultiStore wraps the KVStores of a MultiStore, and of its branches, so the accesses to all of
+// them are recorded in a Tracker.
+type MultiStore struct {
+	types.MultiStore
+	tracker *Tracker
+}
+
+// NewMultiStore returns a MultiStore recording the accesses to the stores of parent in tracker.
+func NewMultiStore(parent types.MultiStore, tracker *Tracker) MultiStore {
+	return MultiStore{MultiStore: parent, tracker: tracker}
+}
+
+// GetStore implements the MultiStore interface.
+func (ms MultiStore) GetStore(key types.StoreKey) types.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements the MultiStore interface.
+func (ms MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return NewStore(ms.MultiStore.GetKVStore(key), key, ms.tracker)
+}
+
+// CacheMultiStore implements the MultiStore interface, the branch is wrapped as well.
+func (ms MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return newCacheMultiStore(ms.MultiStore.CacheMultiStore(), ms.tracker)
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (ms MultiStore) CacheWrap() types.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (ms MultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// SetTracer implements the MultiStore interface.
+func (ms MultiStore) SetTracer(w io.Writer) types.MultiStore {
+	return MultiStore{ms.MultiStore.SetTracer(w), ms.tracker}
+}
+
+// SetTracingContext implements the MultiStore interface.
+func (ms MultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	return MultiStore{ms.MultiStore.SetTracingContext(tc), ms.tracker}
+}
+
+// cacheMultiStore is a MultiStore wrapping a CacheMultiStore.
+type cacheMultiStore struct {
+	MultiStore
+	parent types.CacheMultiStore
+}
+
+func newCacheMultiStore(parent types.CacheMultiStore, tracker *Tracker) cacheMultiStore {
+	return cacheMultiStore{MultiStore: NewMultiStore(parent, tracker), parent: parent}
+}
+
+// Write implements the CacheMultiStore interface.
+func (cms cacheMultiStore) Write() {
+	cms.parent.Write()
+}
+
+// SetTracer implements the MultiStore interface.
+func (cms cacheMultiStore) SetTracer(w io.Writer) types.MultiStore {
+	return newCacheMultiStore(cms.parent.SetTracer(w).(types.CacheMultiStore), cms.tracker)
+}
+
+// SetTracingContext implements the MultiStore interface.
+func (cms cacheMultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	return newCacheMultiStore(cms.parent.SetTracingContext(tc).(types.CacheMultiStore), cms.tracker)
+}
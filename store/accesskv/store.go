@@ -0,0 +1,111 @@
+// Package accesskv implements a KVStore wrapper recording the keys read and written in the
+// store, so the state accessed by a transaction can be analyzed after its execution.
+package accesskv
+
+import (
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface, recording the accesses to the parent store in a
+// Tracker. It records the accesses as they're made, including the writes which are later
+// discarded by a parent branch.
+type Store struct {
+	parent  types.KVStore
+	name    string
+	tracker *Tracker
+}
+
+// NewStore returns a Store recording the accesses to parent, the store with the given key, in
+// tracker.
+func NewStore(parent types.KVStore, key types.StoreKey, tracker *Tracker) *Store {
+	return &Store{parent: parent, name: key.Name(), tracker: tracker}
+}
+
+// GetStoreType implements Store interface
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements the KVStore interface. It records a read and delegates the Get call to the
+// parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.tracker.onRead(s.name, key)
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface. It records a read and delegates the Has call to the
+// parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.tracker.onRead(s.name, key)
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. It records a write and delegates the Set call to the
+// parent KVStore.
+func (s *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	s.parent.Set(key, value)
+	s.tracker.onWrite(s.name, key)
+}
+
+// Delete implements the KVStore interface. It records a write and delegates the Delete call to
+// the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.tracker.onWrite(s.name, key)
+}
+
+// Iterator implements the KVStore interface. It records the iterated range and the keys returned
+// by the iterator.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.tracker.onIterate(s.name, start, end)
+	return newIterator(s.parent.Iterator(start, end), s.name, s.tracker)
+}
+
+// ReverseIterator implements the KVStore interface. It records the iterated range and the keys
+// returned by the iterator.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.tracker.onIterate(s.name, start, end)
+	return newIterator(s.parent.ReverseIterator(start, end), s.name, s.tracker)
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// iterator records the keys returned by its parent iterator as reads.
+type iterator struct {
+	types.Iterator
+	name    string
+	tracker *Tracker
+}
+
+func newIterator(parent types.Iterator, name string, tracker *Tracker) types.Iterator {
+	it := &iterator{Iterator: parent, name: name, tracker: tracker}
+	it.record()
+	return it
+}
+
+// Next implements the Iterator interface.
+func (it *iterator) Next() {
+	it.Iterator.Next()
+	it.record()
+}
+
+func (it *iterator) record() {
+	if it.Iterator.Valid() {
+		it.tracker.onRead(it.name, it.Iterator.Key())
+	}
+}
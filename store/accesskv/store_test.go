@@ -0,0 +1,101 @@
+package accesskv_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/accesskv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	bankKey = types.NewKVStoreKey("bank")
+	accKey  = types.NewKVStoreKey("acc")
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func newMultiStore() types.CacheMultiStore {
+	db := dbm.NewMemDB()
+	for _, key := range []string{"a", "b", "c"} {
+		_ = db.Set(bz(key), bz("value"))
+	}
+	return cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		bankKey: dbadapter.Store{DB: db},
+		accKey:  dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil)
+}
+
+func TestStore(t *testing.T) {
+	tracker := accesskv.NewTracker()
+	store := accesskv.NewStore(newMultiStore().GetKVStore(bankKey), bankKey, tracker)
+
+	require.Equal(t, bz("value"), store.Get(bz("a")))
+	require.False(t, store.Has(bz("z")))
+	store.Set(bz("d"), bz("value"))
+	store.Delete(bz("c"))
+
+	it := store.Iterator(bz("b"), nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+	it = store.ReverseIterator(nil, bz("b"))
+	require.NoError(t, it.Close())
+
+	require.Equal(t, accesskv.AccessSet{
+		bankKey.Name(): {
+			Reads:  [][]byte{bz("a"), bz("b"), bz("d"), bz("z")},
+			Writes: [][]byte{bz("c"), bz("d")},
+			Ranges: []accesskv.Range{{Start: bz("b")}, {End: bz("b")}},
+		},
+	}, tracker.AccessSet())
+}
+
+func TestMultiStore(t *testing.T) {
+	tracker := accesskv.NewTracker()
+	ms := accesskv.NewMultiStore(newMultiStore(), tracker)
+
+	ms.GetKVStore(bankKey).Get(bz("a"))
+
+	// the accesses to the branches are recorded, even if the branch is discarded
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(accKey).Set(bz("x"), bz("value"))
+	branch := cms.SetTracingContext(types.TraceContext{"txHash": "hash"}).(types.CacheMultiStore)
+	branch.CacheMultiStore().GetKVStore(bankKey).Delete(bz("b"))
+	branch.Write()
+
+	require.Equal(t, accesskv.AccessSet{
+		bankKey.Name(): {Reads: [][]byte{bz("a")}, Writes: [][]byte{bz("b")}},
+		accKey.Name():  {Writes: [][]byte{bz("x")}},
+	}, tracker.AccessSet())
+}
+
+func TestAccessSetConflicts(t *testing.T) {
+	as := accesskv.AccessSet{
+		bankKey.Name(): {
+			Reads:  [][]byte{bz("a"), bz("c")},
+			Ranges: []accesskv.Range{{Start: bz("m"), End: bz("p")}},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		other     accesskv.AccessSet
+		conflicts bool
+	}{
+		{"no writes", accesskv.AccessSet{}, false},
+		{"other store", accesskv.AccessSet{accKey.Name(): {Writes: [][]byte{bz("a")}}}, false},
+		{"read key", accesskv.AccessSet{bankKey.Name(): {Writes: [][]byte{bz("c")}}}, true},
+		{"unread key", accesskv.AccessSet{bankKey.Name(): {Writes: [][]byte{bz("b"), bz("p")}}}, false},
+		{"iterated key", accesskv.AccessSet{bankKey.Name(): {Writes: [][]byte{bz("n")}}}, true},
+		{"reads only", accesskv.AccessSet{bankKey.Name(): {Reads: [][]byte{bz("a")}}}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.conflicts, as.Conflicts(tc.other), tc.name)
+	}
+}
@@ -0,0 +1,141 @@
+package accesskv
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// Range is a [Start, End) range of keys iterated over, a nil Start or End being unbounded.
+type Range struct {
+	Start []byte `json:"start,omitempty"`
+	End   []byte `json:"end,omitempty"`
+}
+
+// Contains returns whether key is in the range.
+func (r Range) Contains(key []byte) bool {
+	if r.Start != nil && bytes.Compare(key, r.Start) < 0 {
+		return false
+	}
+	return r.End == nil || bytes.Compare(key, r.End) < 0
+}
+
+// StoreAccess holds the keys read and written in a store, sorted, and the ranges iterated over in
+// the order of the iterations. The keys returned by the iterators are reads.
+type StoreAccess struct {
+	Reads  [][]byte `json:"reads,omitempty"`
+	Writes [][]byte `json:"writes,omitempty"`
+	Ranges []Range  `json:"ranges,omitempty"`
+}
+
+// AccessSet holds the accesses to each store, by store name.
+type AccessSet map[string]*StoreAccess
+
+// Conflicts returns whether a key read or a range iterated over in as is written in other, i.e.
+// whether the accesses of as observe the writes of other.
+func (as AccessSet) Conflicts(other AccessSet) bool {
+	for name, access := range as {
+		written, ok := other[name]
+		if !ok {
+			continue
+		}
+		for _, key := range written.Writes {
+			if containsKey(access.Reads, key) {
+				return true
+			}
+			for _, r := range access.Ranges {
+				if r.Contains(key) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Tracker records the accesses to the stores wrapped with it, it's safe for concurrent use.
+type Tracker struct {
+	mtx    sync.Mutex
+	reads  map[string]map[string]struct{}
+	writes map[string]map[string]struct{}
+	ranges map[string][]Range
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		reads:  make(map[string]map[string]struct{}),
+		writes: make(map[string]map[string]struct{}),
+		ranges: make(map[string][]Range),
+	}
+}
+
+// AccessSet returns the accesses recorded so far.
+func (t *Tracker) AccessSet() AccessSet {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	as := make(AccessSet)
+	access := func(name string) *StoreAccess {
+		if as[name] == nil {
+			as[name] = &StoreAccess{}
+		}
+		return as[name]
+	}
+	for name, keys := range t.reads {
+		access(name).Reads = sortedKeys(keys)
+	}
+	for name, keys := range t.writes {
+		access(name).Writes = sortedKeys(keys)
+	}
+	for name, ranges := range t.ranges {
+		access(name).Ranges = append([]Range{}, ranges...)
+	}
+	return as
+}
+
+func (t *Tracker) onRead(name string, key []byte) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	addKey(t.reads, name, key)
+}
+
+func (t *Tracker) onWrite(name string, key []byte) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	addKey(t.writes, name, key)
+}
+
+func (t *Tracker) onIterate(name string, start, end []byte) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.ranges[name] = append(t.ranges[name], Range{Start: copyKey(start), End: copyKey(end)})
+}
+
+func addKey(keys map[string]map[string]struct{}, name string, key []byte) {
+	if keys[name] == nil {
+		keys[name] = make(map[string]struct{})
+	}
+	keys[name][string(key)] = struct{}{}
+}
+
+func sortedKeys(keys map[string]struct{}) [][]byte {
+	sorted := make([][]byte, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, []byte(key))
+	}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	return sorted
+}
+
+func containsKey(sorted [][]byte, key []byte) bool {
+	i := sort.Search(len(sorted), func(i int) bool { return bytes.Compare(sorted[i], key) >= 0 })
+	return i < len(sorted) && bytes.Equal(sorted[i], key)
+}
+
+func copyKey(key []byte) []byte {
+	if key == nil {
+		return nil
+	}
+	return append([]byte{}, key...)
+}
@@ -0,0 +1,525 @@
+// Package archive implements a versioned key-value archive of the state, fed by the change sets
+// of the committed blocks, which serves reads at past heights without keeping the IAVL versions.
+package archive
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	metaPrefix  = 0x00
+	entryPrefix = 0x01
+
+	// values are prefixed with a marker telling whether the key was set or deleted at the height
+	markerDeleted = 0x00
+	markerSet     = 0x01
+
+	// seedBatchSize is the number of writes after which a seeding batch is written.
+	seedBatchSize = 10_000
+)
+
+var (
+	metaEarliestKey = []byte{metaPrefix, 0x00}
+	metaLatestKey   = []byte{metaPrefix, 0x01}
+
+	errClosed = errors.New("the archive is closed")
+)
+
+// Store archives the history of the keys of a set of stores, as one entry per key and height
+// at which the key was set or deleted, so the value of a key at any archived height is the one of
+// its latest entry at or below the height.
+//
+// Entries are keyed by the store name, the order-preserving escaped key and the big-endian
+// height, so the entries of a key are contiguous and sorted by height, and the keys of a store
+// sorted like the store itself.
+//
+// Store is an ABCIListener archiving the change set of each committed block, which must include
+// all the changes of the archived stores.
+type Store struct {
+	db   dbm.DB
+	keys map[string]types.StoreKey
+
+	mtx      sync.RWMutex
+	earliest int64
+	latest   int64
+
+	// seeding is set while the archive is seeded in the background by SeedAsync, the change
+	// sets written meanwhile being queued until the seeding completes.
+	seeding    bool
+	seedHeight int64
+	queued     []queuedChangeSet
+	seedDone   chan struct{}
+
+	closeOnce sync.Once
+	quit      chan struct{}
+}
+
+// queuedChangeSet is the change set of a block written while the archive is seeded.
+type queuedChangeSet struct {
+	height    int64
+	changeSet []*types.StoreKVPair
+}
+
+var _ types.ResumableABCIListener = (*Store)(nil)
+
+// NewStore returns a Store archiving the stores with the given keys in db.
+func NewStore(db dbm.DB, keys []types.StoreKey) (*Store, error) {
+	s := &Store{db: db, keys: make(map[string]types.StoreKey, len(keys)), quit: make(chan struct{})}
+	for _, key := range keys {
+		s.keys[key.Name()] = key
+	}
+
+	var err error
+	if s.earliest, err = s.getMeta(metaEarliestKey); err != nil {
+		return nil, err
+	}
+	if s.latest, err = s.getMeta(metaLatestKey); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// EarliestHeight returns the earliest height served by the archive, or 0 if it's empty.
+func (s *Store) EarliestHeight() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.earliest
+}
+
+// LatestHeight returns the latest archived height, or 0 if the archive is empty.
+func (s *Store) LatestHeight() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.latest
+}
+
+// HasHeight returns whether the archive serves the state at height.
+func (s *Store) HasHeight(height int64) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.latest > 0 && height >= s.earliest && height <= s.latest
+}
+
+// Seeding returns whether the archive is being seeded in the background.
+func (s *Store) Seeding() bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.seeding
+}
+
+// WriteChangeSet archives the change set of the block at height, which must follow the latest
+// archived height, unless the archive is empty. While the archive is seeded in the background,
+// the change set is queued instead and must follow the seeded or latest queued height, the change
+// sets up to the seeded height being skipped as the seeded state includes them.
+func (s *Store) WriteChangeSet(height int64, changeSet []*types.StoreKVPair) error {
+	s.mtx.Lock()
+	if s.seeding {
+		defer s.mtx.Unlock()
+		if height <= s.seedHeight {
+			return nil
+		}
+		if last := s.lastQueuedHeight(); height != last+1 {
+			return fmt.Errorf("cannot queue height %d while seeding, the latest queued height is %d", height, last)
+		}
+		s.queued = append(s.queued, queuedChangeSet{height: height, changeSet: changeSet})
+		return nil
+	}
+	s.mtx.Unlock()
+
+	return s.writeChangeSet(height, changeSet)
+}
+
+func (s *Store) writeChangeSet(height int64, changeSet []*types.StoreKVPair) error {
+	s.mtx.RLock()
+	earliest, latest := s.earliest, s.latest
+	s.mtx.RUnlock()
+
+	if latest > 0 && height != latest+1 {
+		return fmt.Errorf("cannot archive height %d, the latest archived height is %d", height, latest)
+	}
+	if latest == 0 {
+		earliest = height
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changeSet {
+		if _, ok := s.keys[pair.StoreKey]; !ok {
+			continue
+		}
+		value := []byte{markerDeleted}
+		if !pair.Delete {
+			value = append([]byte{markerSet}, pair.Value...)
+		}
+		if err := batch.Set(entryKey(pair.StoreKey, pair.Key, height), value); err != nil {
+			return err
+		}
+	}
+
+	return s.writeHeights(batch, earliest, height)
+}
+
+// Seed archives the full state of the archived stores at height from ms, which must be a branch
+// of the multistore at height, deleting the archived keys missing from it. It's used to start
+// archiving an existing chain, or to resume archiving after missing blocks, in which case the
+// archive only serves the heights from the seeded height on.
+func (s *Store) Seed(height int64, ms types.MultiStore) error {
+	if latest := s.LatestHeight(); latest >= height {
+		return fmt.Errorf("cannot seed height %d, the latest archived height is %d", height, latest)
+	}
+
+	for _, name := range s.storeNames() {
+		store := ms.GetKVStore(s.keys[name])
+		if err := s.seedStore(name, height, store); err != nil {
+			return fmt.Errorf("failed to seed store %s: %w", name, err)
+		}
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	return s.writeHeights(batch, height, height)
+}
+
+// SeedAsync seeds the archive like Seed, but in the background, so ms must stay readable until
+// done is called. The change sets written meanwhile are queued in memory and archived once the
+// seeding completes, after which done is called with the result. The queued change sets are
+// dropped if the seeding fails, leaving the archive behind the chain as before. Only one seeding
+// can run at a time.
+func (s *Store) SeedAsync(height int64, ms types.MultiStore, done func(error)) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.seeding {
+		return fmt.Errorf("cannot seed height %d, the archive is being seeded at height %d", height, s.seedHeight)
+	}
+	if s.latest >= height {
+		return fmt.Errorf("cannot seed height %d, the latest archived height is %d", height, s.latest)
+	}
+
+	s.seeding, s.seedHeight, s.queued = true, height, nil
+	seedDone := make(chan struct{})
+	s.seedDone = seedDone
+
+	go func() {
+		defer close(seedDone)
+
+		err := s.seedRecover(height, ms)
+		if err == nil {
+			err = s.writeQueued()
+		}
+		if err != nil {
+			s.mtx.Lock()
+			s.seeding, s.queued = false, nil
+			s.mtx.Unlock()
+		}
+		done(err)
+	}()
+	return nil
+}
+
+// seedRecover seeds the archive, converting a panic while reading ms, e.g. because its version
+// was pruned in the meantime, into an error.
+func (s *Store) seedRecover(height int64, ms types.MultiStore) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to read the state at height %d: %v", height, r)
+		}
+	}()
+	return s.Seed(height, ms)
+}
+
+// writeQueued archives the change sets queued while seeding, and ends the seeding once none is
+// left, so that the following change sets are archived directly.
+func (s *Store) writeQueued() error {
+	for {
+		s.mtx.Lock()
+		if len(s.queued) == 0 {
+			s.seeding = false
+			s.mtx.Unlock()
+			return nil
+		}
+		next := s.queued[0]
+		s.queued = s.queued[1:]
+		s.mtx.Unlock()
+
+		if err := s.writeChangeSet(next.height, next.changeSet); err != nil {
+			return err
+		}
+	}
+}
+
+// lastQueuedHeight returns the height of the latest change set queued while seeding, or the
+// seeded height if none is. It must be called with the lock held.
+func (s *Store) lastQueuedHeight() int64 {
+	if n := len(s.queued); n > 0 {
+		return s.queued[n-1].height
+	}
+	return s.seedHeight
+}
+
+func (s *Store) seedStore(name string, height int64, store types.KVStore) error {
+	w := &batchWriter{db: s.db, quit: s.quit}
+	defer w.close()
+
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		if err := w.set(entryKey(name, it.Key(), height), append([]byte{markerSet}, it.Value()...)); err != nil {
+			it.Close()
+			return err
+		}
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	// the keys live in the archive at height but not in the state were deleted in the meantime,
+	// they're collected first as the database may not be written to while iterating
+	var deleted [][]byte
+	archived := newView(s.db, name, height).Iterator(nil, nil)
+	for ; archived.Valid(); archived.Next() {
+		if !store.Has(archived.Key()) {
+			deleted = append(deleted, archived.Key())
+		}
+	}
+	if err := archived.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range deleted {
+		if err := w.set(entryKey(name, key, height), []byte{markerDeleted}); err != nil {
+			return err
+		}
+	}
+	return w.flush()
+}
+
+// KVStore returns a read-only view of the archived store with the given name at height.
+func (s *Store) KVStore(name string, height int64) (types.KVStore, error) {
+	if !s.HasHeight(height) {
+		return nil, s.heightErr(height)
+	}
+	if _, ok := s.keys[name]; !ok {
+		return nil, fmt.Errorf("store %s isn't archived", name)
+	}
+	return newView(s.db, name, height), nil
+}
+
+// CacheMultiStoreWithVersion returns a branch of the archived stores at height, like the
+// CacheMultiStoreWithVersion method of the multistores. It only holds the archived stores.
+func (s *Store) CacheMultiStoreWithVersion(height int64) (types.CacheMultiStore, error) {
+	if !s.HasHeight(height) {
+		return nil, s.heightErr(height)
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(s.keys))
+	for name, key := range s.keys {
+		stores[key] = newView(s.db, name, height)
+	}
+	return cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, s.keys, nil, nil), nil
+}
+
+// Close closes the database of the archive, once the seeding running in the background, if any,
+// is interrupted.
+func (s *Store) Close() error {
+	s.closeOnce.Do(func() { close(s.quit) })
+
+	s.mtx.RLock()
+	seedDone := s.seedDone
+	s.mtx.RUnlock()
+	if seedDone != nil {
+		<-seedDone
+	}
+	return s.db.Close()
+}
+
+// LastDeliveredHeight implements ResumableABCIListener interface. While the archive is seeded,
+// it's the latest queued height.
+func (s *Store) LastDeliveredHeight() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.seeding {
+		return s.lastQueuedHeight()
+	}
+	return s.latest
+}
+
+// ListenBeginBlock implements ABCIListener interface
+func (s *Store) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements ABCIListener interface
+func (s *Store) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock implements ABCIListener interface
+func (s *Store) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements ABCIListener interface
+func (s *Store) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	sdkCtx, ok := ctx.(types.Context)
+	if !ok {
+		return fmt.Errorf("unexpected context type %T, the block height is required", ctx)
+	}
+	return s.WriteChangeSet(sdkCtx.BlockHeight(), changeSet)
+}
+
+func (s *Store) writeHeights(batch dbm.Batch, earliest, latest int64) error {
+	if err := batch.Set(metaEarliestKey, heightBytes(earliest)); err != nil {
+		return err
+	}
+	if err := batch.Set(metaLatestKey, heightBytes(latest)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	s.earliest, s.latest = earliest, latest
+	s.mtx.Unlock()
+	return nil
+}
+
+func (s *Store) getMeta(key []byte) (int64, error) {
+	bz, err := s.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid archive metadata %X", key)
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func (s *Store) storeNames() []string {
+	names := make([]string, 0, len(s.keys))
+	for name := range s.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Store) heightErr(height int64) error {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	if s.latest == 0 {
+		return fmt.Errorf("height %d isn't archived, the archive is empty", height)
+	}
+	return fmt.Errorf("height %d isn't archived, the archived heights are %d to %d", height, s.earliest, s.latest)
+}
+
+// batchWriter writes to the database in batches of at most seedBatchSize writes, until quit is
+// closed.
+type batchWriter struct {
+	db    dbm.DB
+	quit  <-chan struct{}
+	batch dbm.Batch
+	size  int
+}
+
+func (w *batchWriter) set(key, value []byte) error {
+	if w.batch == nil {
+		w.batch = w.db.NewBatch()
+	}
+	if err := w.batch.Set(key, value); err != nil {
+		return err
+	}
+	w.size++
+	if w.size >= seedBatchSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *batchWriter) flush() error {
+	if w.batch == nil {
+		return nil
+	}
+	select {
+	case <-w.quit:
+		w.close()
+		return errClosed
+	default:
+	}
+	err := w.batch.Write()
+	w.close()
+	return err
+}
+
+func (w *batchWriter) close() {
+	if w.batch != nil {
+		_ = w.batch.Close()
+		w.batch = nil
+		w.size = 0
+	}
+}
+
+// storePrefix returns the prefix of the entries of the store with the given name.
+func storePrefix(name string) []byte {
+	prefix := make([]byte, 1, 1+binary.MaxVarintLen64+len(name))
+	prefix[0] = entryPrefix
+	prefix = binary.AppendUvarint(prefix, uint64(len(name)))
+	return append(prefix, name...)
+}
+
+// escapeKey encodes key so that the encoded keys sort like the keys, and no encoded key is a
+// prefix of another once terminated: 0x00 bytes are escaped as 0x00 0xFF, and the terminator is
+// 0x00 0x01.
+func escapeKey(dst, key []byte) []byte {
+	for _, b := range key {
+		if b == 0x00 {
+			dst = append(dst, 0x00, 0xFF)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+	return dst
+}
+
+func unescapeKey(bz []byte) []byte {
+	key := make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		key = append(key, bz[i])
+		if bz[i] == 0x00 {
+			// skip the escape byte
+			i++
+		}
+	}
+	return key
+}
+
+// entryKeyPrefix returns the prefix of the entries of a key, which precedes their height.
+func entryKeyPrefix(name string, key []byte) []byte {
+	return append(escapeKey(storePrefix(name), key), 0x00, 0x01)
+}
+
+func entryKey(name string, key []byte, height int64) []byte {
+	return append(entryKeyPrefix(name, key), heightBytes(height)...)
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
@@ -0,0 +1,214 @@
+package archive
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	bankKey = types.NewKVStoreKey("bank")
+	accKey  = types.NewKVStoreKey("acc")
+)
+
+func set(key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: bankKey.Name(), Key: []byte(key), Value: []byte(value)}
+}
+
+func del(key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: bankKey.Name(), Key: []byte(key), Delete: true}
+}
+
+func collect(t *testing.T, it types.Iterator) []string {
+	t.Helper()
+	defer it.Close()
+
+	var kvs []string
+	for ; it.Valid(); it.Next() {
+		kvs = append(kvs, string(it.Key())+"="+string(it.Value()))
+	}
+	require.NoError(t, it.Error())
+	return kvs
+}
+
+func TestStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	s, err := NewStore(db, []types.StoreKey{bankKey})
+	require.NoError(t, err)
+	require.False(t, s.HasHeight(1))
+
+	require.NoError(t, s.WriteChangeSet(1, []*types.StoreKVPair{
+		set("a", "1"), set("a\x00", "zero"), set("b", "1"), set("c", "1"),
+		{StoreKey: accKey.Name(), Key: []byte("a"), Value: []byte("ignored")},
+	}))
+	require.NoError(t, s.WriteChangeSet(2, []*types.StoreKVPair{set("a", "2"), del("b"), set("c", "x"), set("c", "2")}))
+	require.NoError(t, s.WriteChangeSet(3, nil))
+	require.NoError(t, s.WriteChangeSet(4, []*types.StoreKVPair{set("b", "4"), del("c")}))
+	require.ErrorContains(t, s.WriteChangeSet(6, nil), "latest archived height is 4")
+	require.Equal(t, int64(1), s.EarliestHeight())
+	require.Equal(t, int64(4), s.LatestHeight())
+
+	// the heights are persisted
+	s, err = NewStore(db, []types.StoreKey{bankKey})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), s.LastDeliveredHeight())
+
+	expected := map[int64][]string{
+		1: {"a=1", "a\x00=zero", "b=1", "c=1"},
+		2: {"a=2", "a\x00=zero", "c=2"},
+		3: {"a=2", "a\x00=zero", "c=2"},
+		4: {"a=2", "a\x00=zero", "b=4"},
+	}
+	for height, kvs := range expected {
+		store, err := s.KVStore(bankKey.Name(), height)
+		require.NoError(t, err)
+		require.Equal(t, kvs, collect(t, store.Iterator(nil, nil)), height)
+
+		reversed := make([]string, len(kvs))
+		for i, kv := range kvs {
+			reversed[len(kvs)-1-i] = kv
+		}
+		require.Equal(t, reversed, collect(t, store.ReverseIterator(nil, nil)), height)
+	}
+
+	store, err := s.KVStore(bankKey.Name(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), store.Get([]byte("a")))
+	require.Nil(t, store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("b")))
+	require.Equal(t, []string{"a\x00=zero"}, collect(t, store.Iterator([]byte("a\x00"), []byte("c"))))
+	require.Equal(t, []string{"c=2", "a\x00=zero"}, collect(t, store.ReverseIterator([]byte("a\x00"), nil)))
+	require.Panics(t, func() { store.Set([]byte("a"), []byte("1")) })
+
+	_, err = s.KVStore(bankKey.Name(), 5)
+	require.ErrorContains(t, err, "archived heights are 1 to 4")
+	_, err = s.KVStore(accKey.Name(), 1)
+	require.ErrorContains(t, err, "isn't archived")
+
+	// reads at a past height go through a cache multistore
+	cms, err := s.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(bankKey).Get([]byte("b")))
+	cms.GetKVStore(bankKey).Set([]byte("b"), []byte("cached"))
+	require.Equal(t, []byte("cached"), cms.GetKVStore(bankKey).Get([]byte("b")))
+	store, err = s.KVStore(bankKey.Name(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), store.Get([]byte("b")))
+}
+
+func TestSeed(t *testing.T) {
+	s, err := NewStore(dbm.NewMemDB(), []types.StoreKey{bankKey})
+	require.NoError(t, err)
+	require.NoError(t, s.WriteChangeSet(1, []*types.StoreKVPair{set("a", "1"), set("b", "1")}))
+
+	// the blocks 2 to 4 are missed, the state at height 4 has a deleted and a new key
+	state := dbm.NewMemDB()
+	require.NoError(t, state.Set([]byte("a"), []byte("4")))
+	require.NoError(t, state.Set([]byte("c"), []byte("4")))
+	ms := cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		bankKey: dbadapter.Store{DB: state},
+	}, nil, nil, nil)
+
+	require.ErrorContains(t, s.Seed(1, ms), "latest archived height is 1")
+	require.NoError(t, s.Seed(4, ms))
+	require.Equal(t, int64(4), s.EarliestHeight())
+	require.Equal(t, int64(4), s.LatestHeight())
+	require.False(t, s.HasHeight(1))
+
+	require.NoError(t, s.WriteChangeSet(5, []*types.StoreKVPair{set("d", "5")}))
+	store, err := s.KVStore(bankKey.Name(), 4)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=4", "c=4"}, collect(t, store.Iterator(nil, nil)))
+	store, err = s.KVStore(bankKey.Name(), 5)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a=4", "c=4", "d=5"}, collect(t, store.Iterator(nil, nil)))
+}
+
+func TestEscapeKey(t *testing.T) {
+	keys := []string{"", "\x00", "\x00\x00", "\x00\x01", "\x00\xff", "\x01", "a", "a\x00", "a\x00b", "ab", "\xff"}
+	for i, key := range keys {
+		require.Equal(t, []byte(key), unescapeKey(escapeKey(nil, []byte(key))))
+		if i > 0 {
+			prev := entryKey("bank", []byte(keys[i-1]), 10)
+			require.Negative(t, bytes.Compare(prev, entryKeyPrefix("bank", []byte(key))), "%q < %q", keys[i-1], key)
+		}
+	}
+}
+
+// blockingStore is a store whose iterators wait for unblock to be closed, or panic if it's nil.
+type blockingStore struct {
+	dbadapter.Store
+	unblock chan struct{}
+}
+
+func (s blockingStore) Iterator(start, end []byte) types.Iterator {
+	if s.unblock == nil {
+		panic("version does not exist")
+	}
+	<-s.unblock
+	return s.Store.Iterator(start, end)
+}
+
+func TestSeedAsync(t *testing.T) {
+	s, err := NewStore(dbm.NewMemDB(), []types.StoreKey{bankKey})
+	require.NoError(t, err)
+	require.NoError(t, s.WriteChangeSet(1, []*types.StoreKVPair{set("a", "1"), set("b", "1")}))
+
+	state := dbm.NewMemDB()
+	require.NoError(t, state.Set([]byte("a"), []byte("4")))
+	unblock := make(chan struct{})
+	ms := cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		bankKey: blockingStore{Store: dbadapter.Store{DB: state}, unblock: unblock},
+	}, nil, nil, nil)
+
+	done := make(chan error, 1)
+	require.ErrorContains(t, s.SeedAsync(1, ms, func(err error) { done <- err }), "latest archived height is 1")
+	require.NoError(t, s.SeedAsync(4, ms, func(err error) { done <- err }))
+	require.True(t, s.Seeding())
+	require.ErrorContains(t, s.SeedAsync(5, ms, nil), "being seeded at height 4")
+
+	// the blocks following the seeded height are queued until the seeding completes, the ones
+	// included in the seeded state are skipped
+	require.NoError(t, s.WriteChangeSet(4, []*types.StoreKVPair{set("x", "4")}))
+	require.ErrorContains(t, s.WriteChangeSet(6, nil), "latest queued height is 4")
+	require.NoError(t, s.WriteChangeSet(5, []*types.StoreKVPair{set("c", "5")}))
+	require.NoError(t, s.WriteChangeSet(6, []*types.StoreKVPair{del("a")}))
+	require.Equal(t, int64(6), s.LastDeliveredHeight())
+	require.Equal(t, int64(1), s.LatestHeight())
+	require.True(t, s.HasHeight(1))
+
+	close(unblock)
+	require.NoError(t, <-done)
+	require.False(t, s.Seeding())
+	require.Equal(t, int64(4), s.EarliestHeight())
+	require.Equal(t, int64(6), s.LatestHeight())
+	require.NoError(t, s.WriteChangeSet(7, []*types.StoreKVPair{set("d", "7")}))
+
+	expected := map[int64][]string{
+		4: {"a=4"},
+		5: {"a=4", "c=5"},
+		6: {"c=5"},
+		7: {"c=5", "d=7"},
+	}
+	for height, kvs := range expected {
+		store, err := s.KVStore(bankKey.Name(), height)
+		require.NoError(t, err)
+		require.Equal(t, kvs, collect(t, store.Iterator(nil, nil)), height)
+	}
+
+	// a failed seeding drops the queued blocks and leaves the archive as it was
+	ms = cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		bankKey: blockingStore{Store: dbadapter.Store{DB: state}},
+	}, nil, nil, nil)
+	require.NoError(t, s.SeedAsync(9, ms, func(err error) { done <- err }))
+	require.ErrorContains(t, <-done, "failed to read the state at height 9: version does not exist")
+	require.False(t, s.Seeding())
+	require.Equal(t, int64(7), s.LastDeliveredHeight())
+	require.ErrorContains(t, s.WriteChangeSet(10, nil), "latest archived height is 7")
+}
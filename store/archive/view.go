@@ -0,0 +1,224 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var errKeyEmpty = errors.New("key cannot be empty")
+
+// view is a read-only KVStore of an archived store at a height.
+type view struct {
+	db     dbm.DB
+	prefix []byte
+	height int64
+}
+
+var _ types.KVStore = (*view)(nil)
+
+func newView(db dbm.DB, name string, height int64) *view {
+	return &view{db: db, prefix: storePrefix(name), height: height}
+}
+
+// GetStoreType implements Store interface
+func (v *view) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements CacheWrapper interface
+func (v *view) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(v)
+}
+
+// CacheWrapWithTrace implements CacheWrapper interface
+func (v *view) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(v, w, tc))
+}
+
+// Get implements KVStore interface, returning the value of the latest entry of the key at or
+// below the height of the view.
+func (v *view) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	prefix := escapeKey(append([]byte{}, v.prefix...), key)
+	prefix = append(prefix, 0x00, 0x01)
+	it, err := v.db.ReverseIterator(prefix, append(prefix, heightBytes(v.height+1)...))
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+	value := it.Value()
+	if value[0] != markerSet {
+		return nil
+	}
+	return value[1:]
+}
+
+// Has implements KVStore interface
+func (v *view) Has(key []byte) bool {
+	return v.Get(key) != nil
+}
+
+// Set implements KVStore interface, it panics as the archived state is read-only.
+func (v *view) Set(_, _ []byte) {
+	panic("archived state is read-only")
+}
+
+// Delete implements KVStore interface, it panics as the archived state is read-only.
+func (v *view) Delete(_ []byte) {
+	panic("archived state is read-only")
+}
+
+// Iterator implements KVStore interface
+func (v *view) Iterator(start, end []byte) types.Iterator {
+	return v.newIterator(start, end, false)
+}
+
+// ReverseIterator implements KVStore interface
+func (v *view) ReverseIterator(start, end []byte) types.Iterator {
+	return v.newIterator(start, end, true)
+}
+
+func (v *view) newIterator(start, end []byte, reverse bool) types.Iterator {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		panic(errKeyEmpty)
+	}
+
+	// the escaping preserves the order of the keys, so the entries of the keys in [start, end)
+	// are the ones in [escaped start, escaped end)
+	dbStart := escapeKey(append([]byte{}, v.prefix...), start)
+	var dbEnd []byte
+	if end != nil {
+		dbEnd = escapeKey(append([]byte{}, v.prefix...), end)
+	} else {
+		dbEnd = types.PrefixEndBytes(v.prefix)
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = v.db.ReverseIterator(dbStart, dbEnd)
+	} else {
+		it, err = v.db.Iterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	iter := &iterator{
+		it:        it,
+		prefixLen: len(v.prefix),
+		height:    v.height,
+		reverse:   reverse,
+		start:     start,
+		end:       end,
+	}
+	iter.seek()
+	return iter
+}
+
+// iterator iterates over the keys of an archived store at a height, skipping the keys deleted or
+// not set yet at the height.
+type iterator struct {
+	it        dbm.Iterator
+	prefixLen int
+	height    int64
+	reverse   bool
+	start     []byte
+	end       []byte
+
+	valid bool
+	key   []byte
+	value []byte
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+// seek moves to the next key live at the height, going over all the entries of each key.
+func (it *iterator) seek() {
+	for it.it.Valid() {
+		entry := it.it.Key()
+		keyPrefix := append([]byte{}, entry[:len(entry)-8]...)
+
+		var value []byte
+		for ; it.it.Valid() && bytes.HasPrefix(it.it.Key(), keyPrefix) && len(it.it.Key()) == len(keyPrefix)+8; it.it.Next() {
+			entry = it.it.Key()
+			if int64(binary.BigEndian.Uint64(entry[len(keyPrefix):])) > it.height {
+				continue
+			}
+			// the entries are sorted by ascending height when iterating forward, the value
+			// at the height is the last one, and the first one when iterating in reverse
+			if !it.reverse || value == nil {
+				value = it.it.Value()
+			}
+		}
+
+		if value != nil && value[0] == markerSet {
+			it.valid = true
+			// strip the terminator from the escaped key
+			it.key = unescapeKey(keyPrefix[it.prefixLen : len(keyPrefix)-2])
+			it.value = value[1:]
+			return
+		}
+	}
+	it.valid = false
+	it.key, it.value = nil, nil
+}
+
+// Domain implements Iterator interface
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator interface
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator interface
+func (it *iterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.seek()
+}
+
+// Key implements Iterator interface
+func (it *iterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements Iterator interface
+func (it *iterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements Iterator interface
+func (it *iterator) Error() error {
+	return it.it.Error()
+}
+
+// Close implements Iterator interface
+func (it *iterator) Close() error {
+	return it.it.Close()
+}
@@ -1,6 +1,7 @@
 package iavl
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -348,18 +349,53 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		}
 
 		// Continue to prove existence/absence of value
-		// Must convert store.Tree to iavl.MutableTree with given version to use in CreateProof
-		iTree, err := tree.GetImmutable(res.Height)
-		if err != nil {
-			// sanity check: If value for given version was retrieved, immutable tree must also be retrievable
-			panic(fmt.Sprintf("version exists in store but could not retrieve corresponding versioned tree in store, %s", err.Error()))
+		// get proof from tree and convert to merkle.Proof before adding to result
+		res.ProofOps = getProofFromTree(st.mutableTreeAt(res.Height), req.Data, res.Value != nil)
+
+	case "/keys": // get by keys, the keys being the ones of the kv.Pairs in the data
+		var keys kv.Pairs
+		if err := keys.Unmarshal(req.Data); err != nil {
+			return types.QueryResult(errorsmod.Wrap(types.ErrTxDecode, err.Error()), false)
 		}
-		mtree := &iavl.MutableTree{
-			ImmutableTree: iTree,
+
+		res.Key = req.Data
+		if !st.VersionExists(res.Height) {
+			res.Log = iavl.ErrVersionDoesNotExist.Error()
+			break
 		}
 
-		// get proof from tree and convert to merkle.Proof before adding to result
-		res.ProofOps = getProofFromTree(mtree, req.Data, res.Value != nil)
+		mtree := st.mutableTreeAt(res.Height)
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0, len(keys.Pairs)),
+		}
+		var proofOps []cmtprotocrypto.ProofOp
+		for _, pair := range keys.Pairs {
+			if len(pair.Key) == 0 {
+				return types.QueryResult(errorsmod.Wrap(types.ErrTxDecode, "query key cannot be zero length"), false)
+			}
+
+			value, err := mtree.Get(pair.Key)
+			if err != nil {
+				panic(err)
+			}
+			// only the keys found are returned, the proofs prove the absence of the others
+			if value != nil {
+				pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: pair.Key, Value: value})
+			}
+			if req.Prove {
+				proofOps = append(proofOps, getProofFromTree(mtree, pair.Key, value != nil).Ops...)
+			}
+		}
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+		res.Value = bz
+
+		if req.Prove {
+			res.ProofOps = &cmtprotocrypto.ProofOps{Ops: proofOps}
+		}
 
 	case "/subspace":
 		pairs := kv.Pairs{
@@ -369,7 +405,22 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		subspace := req.Data
 		res.Key = subspace
 
-		iterator := types.KVStorePrefixIterator(st, subspace)
+		// the proved listings are read from the tree at the height of the proof, while the
+		// others are read from the latest tree
+		var (
+			parent types.KVStore = st
+			mtree  *iavl.MutableTree
+		)
+		if req.Prove {
+			if !st.VersionExists(res.Height) {
+				res.Log = iavl.ErrVersionDoesNotExist.Error()
+				break
+			}
+			mtree = st.mutableTreeAt(res.Height)
+			parent = &Store{tree: &immutableTree{mtree.ImmutableTree}, metrics: st.metrics}
+		}
+
+		iterator := types.KVStorePrefixIterator(parent, subspace)
 		for ; iterator.Valid(); iterator.Next() {
 			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
 		}
@@ -382,6 +433,10 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 
 		res.Value = bz
 
+		if req.Prove {
+			res.ProofOps = getRangeProofFromTree(mtree, subspace, pairs)
+		}
+
 	default:
 		return types.QueryResult(errorsmod.Wrapf(types.ErrUnknownRequest, "unexpected query path: %v", req.Path), false)
 	}
@@ -389,6 +444,19 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return res
 }
 
+// mutableTreeAt returns the tree at the given version, which must exist, as an iavl.MutableTree
+// to create proofs from it.
+func (st *Store) mutableTreeAt(version int64) *iavl.MutableTree {
+	iTree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		// sanity check: If the version exists, immutable tree must also be retrievable
+		panic(fmt.Sprintf("version exists in store but could not retrieve corresponding versioned tree in store, %s", err.Error()))
+	}
+	return &iavl.MutableTree{
+		ImmutableTree: iTree,
+	}
+}
+
 // TraverseStateChanges traverses the state changes between two versions and calls the given function.
 func (st *Store) TraverseStateChanges(startVersion, endVersion int64, fn func(version int64, changeSet *iavl.ChangeSet) error) error {
 	return st.tree.TraverseStateChanges(startVersion, endVersion, fn)
@@ -422,3 +490,30 @@ func getProofFromTree(tree *iavl.MutableTree, key []byte, exists bool) *cmtproto
 	op := types.NewIavlCommitmentOp(key, commitmentProof)
 	return &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op.ProofOp()}}
 }
+
+// getRangeProofFromTree returns the proof of the listing of the keys with the given prefix, pairs
+// being all the pairs with the prefix in tree. It's the existence proof of each listed key,
+// chained by the absence proofs of:
+//   - the prefix, if it isn't the first listed key, whose right neighbor is the first listed key,
+//   - the immediate successor of each listed key, key||0x00, whose right neighbor is the next
+//     listed key, or a key without the prefix for the last listed key.
+//
+// The absence proofs are skipped when the successor of a listed key is the next listed key.
+func getRangeProofFromTree(tree *iavl.MutableTree, prefix []byte, pairs kv.Pairs) *cmtprotocrypto.ProofOps {
+	proofOps := &cmtprotocrypto.ProofOps{}
+	if len(pairs.Pairs) == 0 || !bytes.Equal(pairs.Pairs[0].Key, prefix) {
+		proofOps.Ops = append(proofOps.Ops, getProofFromTree(tree, prefix, false).Ops...)
+	}
+
+	for i, pair := range pairs.Pairs {
+		proofOps.Ops = append(proofOps.Ops, getProofFromTree(tree, pair.Key, true).Ops...)
+
+		successor := append(append([]byte{}, pair.Key...), 0x00)
+		if i+1 < len(pairs.Pairs) && bytes.Equal(pairs.Pairs[i+1].Key, successor) {
+			continue
+		}
+		proofOps.Ops = append(proofOps.Ops, getProofFromTree(tree, successor, false).Ops...)
+	}
+
+	return proofOps
+}
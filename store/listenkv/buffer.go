@@ -0,0 +1,130 @@
+package listenkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	bufferChangeSetPrefix = 0x00
+	bufferCommitPrefix    = 0x01
+
+	bufferVersion = 0x01
+
+	// maxBufferedPairSize bounds the size of a buffered StoreKVPair, which is limited by the
+	// maximum key and value lengths.
+	maxBufferedPairSize = math.MaxInt32
+)
+
+// Buffer keeps the change sets of the recent blocks, along with their commit responses, in a
+// database, so they can be replayed to the ABCI listeners which missed them.
+type Buffer struct {
+	db dbm.DB
+}
+
+// NewBuffer returns a Buffer backed by db.
+func NewBuffer(db dbm.DB) *Buffer {
+	return &Buffer{db: db}
+}
+
+// WriteChangeSet durably saves the change set of the block at height. It's meant to be called
+// before the block is committed, so a change set is never lost once its block is committed.
+func (b *Buffer) WriteChangeSet(height int64, changeSet []*types.StoreKVPair) error {
+	// the change set is prefixed with a version byte, so empty change sets are stored as well
+	buf := bytes.NewBuffer([]byte{bufferVersion})
+	w := protoio.NewDelimitedWriter(buf)
+	for _, pair := range changeSet {
+		if err := w.WriteMsg(pair); err != nil {
+			return err
+		}
+	}
+	return b.db.SetSync(bufferKey(bufferChangeSetPrefix, height), buf.Bytes())
+}
+
+// WriteCommit saves the commit response of the block at height.
+func (b *Buffer) WriteCommit(height int64, res abci.ResponseCommit) error {
+	bz, err := res.Marshal()
+	if err != nil {
+		return err
+	}
+	return b.db.Set(bufferKey(bufferCommitPrefix, height), bz)
+}
+
+// Read returns the change set and commit response of the block at height. found is false if the
+// change set of the block isn't buffered, and the commit response is empty if it wasn't saved.
+func (b *Buffer) Read(height int64) (changeSet []*types.StoreKVPair, res abci.ResponseCommit, found bool, err error) {
+	bz, err := b.db.Get(bufferKey(bufferChangeSetPrefix, height))
+	if err != nil || bz == nil {
+		return nil, res, false, err
+	}
+
+	if len(bz) == 0 || bz[0] != bufferVersion {
+		return nil, res, false, errors.Wrapf(types.ErrLogic, "invalid buffered change set at height %d", height)
+	}
+	r := protoio.NewDelimitedReader(bytes.NewReader(bz[1:]), maxBufferedPairSize)
+	for {
+		pair := &types.StoreKVPair{}
+		if err := r.ReadMsg(pair); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, res, false, errors.Wrapf(err, "invalid buffered change set at height %d", height)
+		}
+		changeSet = append(changeSet, pair)
+	}
+
+	bz, err = b.db.Get(bufferKey(bufferCommitPrefix, height))
+	if err != nil {
+		return nil, res, false, err
+	}
+	if err := res.Unmarshal(bz); err != nil {
+		return nil, res, false, errors.Wrapf(err, "invalid buffered commit response at height %d", height)
+	}
+
+	return changeSet, res, true, nil
+}
+
+// Prune removes the blocks up to height, included, from the buffer.
+func (b *Buffer) Prune(height int64) error {
+	batch := b.db.NewBatch()
+	defer batch.Close()
+
+	for _, prefix := range []byte{bufferChangeSetPrefix, bufferCommitPrefix} {
+		it, err := b.db.Iterator([]byte{prefix}, bufferKey(prefix, height+1))
+		if err != nil {
+			return err
+		}
+		for ; it.Valid(); it.Next() {
+			if err := batch.Delete(it.Key()); err != nil {
+				it.Close()
+				return err
+			}
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// Close closes the database of the buffer.
+func (b *Buffer) Close() error {
+	return b.db.Close()
+}
+
+func bufferKey(prefix byte, height int64) []byte {
+	key := make([]byte, 9)
+	key[0] = prefix
+	binary.BigEndian.PutUint64(key[1:], uint64(height))
+	return key
+}
@@ -0,0 +1,49 @@
+package listenkv_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/types"
+)
+
+func TestBuffer(t *testing.T) {
+	buffer := listenkv.NewBuffer(dbm.NewMemDB())
+
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: "acc", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "bank", Key: []byte("b"), Delete: true},
+	}
+	require.NoError(t, buffer.WriteChangeSet(1, changeSet))
+	require.NoError(t, buffer.WriteCommit(1, abci.ResponseCommit{Data: []byte("hash"), RetainHeight: 1}))
+	// empty change sets are buffered, the commit response may be missing
+	require.NoError(t, buffer.WriteChangeSet(2, nil))
+
+	actual, res, found, err := buffer.Read(1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, changeSet, actual)
+	require.Equal(t, abci.ResponseCommit{Data: []byte("hash"), RetainHeight: 1}, res)
+
+	actual, res, found, err = buffer.Read(2)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Empty(t, actual)
+	require.Equal(t, abci.ResponseCommit{}, res)
+
+	_, _, found, err = buffer.Read(3)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, buffer.Prune(1))
+	_, _, found, err = buffer.Read(1)
+	require.NoError(t, err)
+	require.False(t, found)
+	_, _, found, err = buffer.Read(2)
+	require.NoError(t, err)
+	require.True(t, found)
+}
@@ -9,6 +9,8 @@ import (
 // StoreMetrics defines the set of metrics for the store package
 type StoreMetrics interface {
 	MeasureSince(keys ...string)
+	IncrCounter(val float32, keys ...string)
+	SetGauge(val float32, keys ...string)
 }
 
 var (
@@ -44,6 +46,18 @@ func (m Metrics) MeasureSince(keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
 
+// IncrCounter provides a wrapper functionality for incrementing a counter metric
+// with global labels (if any).
+func (m Metrics) IncrCounter(val float32, keys ...string) {
+	metrics.IncrCounterWithLabels(keys, val, m.Labels)
+}
+
+// SetGauge provides a wrapper functionality for setting a gauge metric with
+// global labels (if any).
+func (m Metrics) SetGauge(val float32, keys ...string) {
+	metrics.SetGaugeWithLabels(keys, val, m.Labels)
+}
+
 // NoOpMetrics is a no-op implementation of the StoreMetrics interface
 type NoOpMetrics struct{}
 
@@ -54,3 +68,9 @@ func NewNoOpMetrics() NoOpMetrics {
 
 // MeasureSince is a no-op implementation of the StoreMetrics interface to avoid time.Now() calls
 func (m NoOpMetrics) MeasureSince(keys ...string) {}
+
+// IncrCounter is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) IncrCounter(val float32, keys ...string) {}
+
+// SetGauge is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) SetGauge(val float32, keys ...string) {}
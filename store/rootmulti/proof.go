@@ -1,18 +1,240 @@
 package rootmulti
 
 import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/internal/kv"
 	storetypes "cosmossdk.io/store/types"
 )
 
 // RequireProof returns whether proof is required for the subpath.
 func RequireProof(subpath string) bool {
 	// XXX: create a better convention.
-	// Currently, only when query subpath is "/key", will proof be included in
-	// response. If there are some changes about proof building in iavlstore.go,
+	// Currently, only when query subpath is "/key", "/keys" or "/subspace", will proof be
+	// included in response. If there are some changes about proof building in iavlstore.go,
 	// we must change code here to keep consistency with iavlStore#Query.
-	return subpath == "/key"
+	switch subpath {
+	case "/key", "/keys", "/subspace":
+		return true
+	default:
+		return false
+	}
+}
+
+// EncodeQueryKeys encodes the keys queried by a "/keys" query, as the request data.
+func EncodeQueryKeys(keys [][]byte) ([]byte, error) {
+	pairs := kv.Pairs{Pairs: make([]kv.Pair, len(keys))}
+	for i, key := range keys {
+		pairs.Pairs[i].Key = key
+	}
+	return pairs.Marshal()
+}
+
+// VerifyBatchProof verifies the response of a "/keys" query of the store with the given name
+// with a proof, against the app hash of the block following the query height. The response
+// value must hold the pairs of the keys found, and the proof must prove the existence of each of
+// them and the absence of the other keys.
+func VerifyBatchProof(appHash []byte, storeName string, keys [][]byte, res abci.ResponseQuery) error {
+	pairs, keyOps, err := decodeStoreProof(res)
+	if err != nil {
+		return err
+	}
+	if len(keyOps) != len(keys) {
+		return errorsmod.Wrapf(storetypes.ErrInvalidProof, "expected %d key proofs, got %d", len(keys), len(keyOps))
+	}
+
+	values := make(map[string][]byte, len(pairs.Pairs))
+	for _, pair := range pairs.Pairs {
+		values[string(pair.Key)] = pair.Value
+	}
+
+	var root []byte
+	for i, key := range keys {
+		value, found := values[string(key)]
+		delete(values, string(key))
+		keyRoot, err := runKeyOp(keyOps[i], key, value, found)
+		if err != nil {
+			return err
+		}
+		if root, err = checkSameRoot(root, keyRoot); err != nil {
+			return err
+		}
+	}
+	if len(values) > 0 {
+		return errorsmod.Wrap(storetypes.ErrInvalidProof, "the response holds keys which were not queried")
+	}
+
+	return verifyStoreRoot(appHash, storeName, root, res.ProofOps)
+}
+
+// VerifyRangeProof verifies the response of a "/subspace" query of the store with the given
+// name with a proof, against the app hash of the block following the query height. It proves
+// that the response value holds all the pairs with the given prefix, and only them.
+//
+// The proof holds the existence proof of each listed key, chained by absence proofs proving that
+// no key lies between the prefix and the first listed key, between two listed keys or after the
+// last listed key with the prefix: the absence proof of a key, being between two neighbor keys of
+// the tree, proves that no key lies between the neighbors.
+func VerifyRangeProof(appHash []byte, storeName string, prefix []byte, res abci.ResponseQuery) error {
+	pairs, keyOps, err := decodeStoreProof(res)
+	if err != nil {
+		return err
+	}
+
+	var (
+		root []byte
+		next = 0
+	)
+	// verifyGap verifies that the next op proves the absence of key, whose right neighbor must be
+	// the next listed key, or a key without the prefix after the last listed key.
+	verifyGap := func(key []byte, nextKey []byte) error {
+		if next >= len(keyOps) {
+			return errorsmod.Wrap(storetypes.ErrInvalidProof, "range proof is missing key proofs")
+		}
+		op := keyOps[next]
+		next++
+
+		keyRoot, err := runKeyOp(op, key, nil, false)
+		if err != nil {
+			return err
+		}
+		if root, err = checkSameRoot(root, keyRoot); err != nil {
+			return err
+		}
+
+		right := op.Proof.GetNonexist().GetRight()
+		switch {
+		case nextKey != nil && (right == nil || !bytes.Equal(right.Key, nextKey)):
+			return errorsmod.Wrapf(storetypes.ErrInvalidProof, "key %X isn't the successor of %X", nextKey, key)
+		case nextKey == nil && right != nil && bytes.HasPrefix(right.Key, prefix):
+			return errorsmod.Wrapf(storetypes.ErrInvalidProof, "key %X is missing from the range", right.Key)
+		}
+		return nil
+	}
+
+	nextKey := func(i int) []byte {
+		if i < len(pairs.Pairs) {
+			return pairs.Pairs[i].Key
+		}
+		return nil
+	}
+
+	if first := nextKey(0); first == nil || !bytes.Equal(first, prefix) {
+		if err := verifyGap(prefix, first); err != nil {
+			return err
+		}
+	}
+	for i, pair := range pairs.Pairs {
+		if !bytes.HasPrefix(pair.Key, prefix) {
+			return errorsmod.Wrapf(storetypes.ErrInvalidProof, "key %X doesn't have the prefix %X", pair.Key, prefix)
+		}
+		if next >= len(keyOps) {
+			return errorsmod.Wrap(storetypes.ErrInvalidProof, "range proof is missing key proofs")
+		}
+		keyRoot, err := runKeyOp(keyOps[next], pair.Key, pair.Value, true)
+		if err != nil {
+			return err
+		}
+		next++
+		if root, err = checkSameRoot(root, keyRoot); err != nil {
+			return err
+		}
+
+		successor := append(append([]byte{}, pair.Key...), 0x00)
+		if bytes.Equal(nextKey(i+1), successor) {
+			continue
+		}
+		if err := verifyGap(successor, nextKey(i+1)); err != nil {
+			return err
+		}
+	}
+	if next != len(keyOps) {
+		return errorsmod.Wrapf(storetypes.ErrInvalidProof, "range proof has %d unexpected key proofs", len(keyOps)-next)
+	}
+
+	return verifyStoreRoot(appHash, storeName, root, res.ProofOps)
+}
+
+// decodeStoreProof decodes the pairs of the response value, along with the key ops of its proof,
+// which are all the ops but the last one, proving the store root in the commit info.
+func decodeStoreProof(res abci.ResponseQuery) (kv.Pairs, []storetypes.CommitmentOp, error) {
+	var pairs kv.Pairs
+	if err := pairs.Unmarshal(res.Value); err != nil {
+		return pairs, nil, errorsmod.Wrap(storetypes.ErrInvalidProof, err.Error())
+	}
+	if res.ProofOps == nil || len(res.ProofOps.Ops) < 2 {
+		return pairs, nil, errorsmod.Wrap(storetypes.ErrInvalidProof, "proof is unexpectedly empty")
+	}
+
+	ops := res.ProofOps.Ops[:len(res.ProofOps.Ops)-1]
+	keyOps := make([]storetypes.CommitmentOp, len(ops))
+	for i, pop := range ops {
+		if pop.Type != storetypes.ProofOpIAVLCommitment {
+			return pairs, nil, errorsmod.Wrapf(storetypes.ErrInvalidProof, "unexpected key proof type %s", pop.Type)
+		}
+		op, err := storetypes.CommitmentOpDecoder(pop)
+		if err != nil {
+			return pairs, nil, err
+		}
+		keyOps[i] = op.(storetypes.CommitmentOp)
+	}
+	return pairs, keyOps, nil
+}
+
+// runKeyOp verifies that op proves the existence of key with value if found, or its absence,
+// returning the store root.
+func runKeyOp(op storetypes.CommitmentOp, key, value []byte, found bool) ([]byte, error) {
+	if !bytes.Equal(op.Key, key) {
+		return nil, errorsmod.Wrapf(storetypes.ErrInvalidProof, "expected proof of key %X, got %X", key, op.Key)
+	}
+
+	var args [][]byte
+	if found {
+		args = [][]byte{value}
+	}
+	root, err := op.Run(args)
+	if err != nil {
+		return nil, err
+	}
+	return root[0], nil
+}
+
+// checkSameRoot checks that all the key proofs prove the same store root.
+func checkSameRoot(root, keyRoot []byte) ([]byte, error) {
+	if root != nil && !bytes.Equal(root, keyRoot) {
+		return nil, errorsmod.Wrap(storetypes.ErrInvalidProof, "key proofs prove different store roots")
+	}
+	return keyRoot, nil
+}
+
+// verifyStoreRoot verifies the store root against the app hash with the last proof op, proving
+// the store root in the commit info.
+func verifyStoreRoot(appHash []byte, storeName string, root []byte, proofOps *cmtprotocrypto.ProofOps) error {
+	if root == nil {
+		return errorsmod.Wrap(storetypes.ErrInvalidProof, "proof has no key proofs")
+	}
+
+	pop := proofOps.Ops[len(proofOps.Ops)-1]
+	if pop.Type != storetypes.ProofOpSimpleMerkleCommitment || string(pop.Key) != storeName {
+		return errorsmod.Wrapf(storetypes.ErrInvalidProof, "expected commit info proof of store %s", storeName)
+	}
+	op, err := storetypes.CommitmentOpDecoder(pop)
+	if err != nil {
+		return err
+	}
+	hash, err := op.Run([][]byte{root})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash[0], appHash) {
+		return errorsmod.Wrapf(storetypes.ErrInvalidProof, "calculated app hash %X doesn't match %X", hash[0], appHash)
+	}
+	return nil
 }
 
 //-----------------------------------------------------------------------------
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/internal/kv"
 	"cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/types"
 )
@@ -148,3 +149,98 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.ProofOps, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func newProofTestStore(t *testing.T, keys ...string) (*Store, types.CommitID) {
+	t.Helper()
+
+	store := NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(types.NewKVStoreKey("otherStoreKey"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	iavlStore := store.GetCommitStore(iavlStoreKey).(*iavl.Store)
+	for _, key := range keys {
+		iavlStore.Set([]byte(key), []byte("value-"+key))
+	}
+	return store, store.Commit()
+}
+
+func TestVerifyBatchProof(t *testing.T) {
+	store, cid := newProofTestStore(t, "a", "b", "c")
+
+	keys := [][]byte{[]byte("a"), []byte("aa"), []byte("c"), []byte("d")}
+	data, err := EncodeQueryKeys(keys)
+	require.NoError(t, err)
+	res := store.Query(abci.RequestQuery{Path: "/iavlStoreKey/keys", Data: data, Height: cid.Version, Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Equal(t, []kv.Pair{
+		{Key: []byte("a"), Value: []byte("value-a")},
+		{Key: []byte("c"), Value: []byte("value-c")},
+	}, pairs.Pairs)
+	require.NoError(t, VerifyBatchProof(cid.Hash, "iavlStoreKey", keys, res))
+
+	require.Error(t, VerifyBatchProof(cid.Hash, "otherStoreKey", keys, res))
+	require.Error(t, VerifyBatchProof([]byte("invalid"), "iavlStoreKey", keys, res))
+	require.Error(t, VerifyBatchProof(cid.Hash, "iavlStoreKey", keys[:3], res))
+
+	// a found key is omitted from the response
+	tampered := res
+	tampered.Value, err = (&kv.Pairs{Pairs: pairs.Pairs[:1]}).Marshal()
+	require.NoError(t, err)
+	require.Error(t, VerifyBatchProof(cid.Hash, "iavlStoreKey", keys, tampered))
+
+	// a value is altered
+	tampered.Value, err = (&kv.Pairs{Pairs: []kv.Pair{pairs.Pairs[0], {Key: []byte("c"), Value: []byte("altered")}}}).Marshal()
+	require.NoError(t, err)
+	require.Error(t, VerifyBatchProof(cid.Hash, "iavlStoreKey", keys, tampered))
+}
+
+func TestVerifyRangeProof(t *testing.T) {
+	store, cid := newProofTestStore(t, "a", "b", "b\x00", "ba", "bb\x01", "c", "d")
+
+	testCases := []struct {
+		prefix string
+		keys   []string
+	}{
+		{"b", []string{"b", "b\x00", "ba", "bb\x01"}},
+		{"bb", []string{"bb\x01"}},
+		{"a", []string{"a"}},
+		{"d", []string{"d"}},
+		{"0", nil},
+		{"bc", nil},
+		{"e", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.prefix, func(t *testing.T) {
+			res := store.Query(abci.RequestQuery{Path: "/iavlStoreKey/subspace", Data: []byte(tc.prefix), Height: cid.Version, Prove: true})
+			require.True(t, res.IsOK(), res.Log)
+
+			var pairs kv.Pairs
+			require.NoError(t, pairs.Unmarshal(res.Value))
+			var keys []string
+			for _, pair := range pairs.Pairs {
+				keys = append(keys, string(pair.Key))
+			}
+			require.Equal(t, tc.keys, keys)
+			require.NoError(t, VerifyRangeProof(cid.Hash, "iavlStoreKey", []byte(tc.prefix), res))
+
+			require.Error(t, VerifyRangeProof([]byte("invalid"), "iavlStoreKey", []byte(tc.prefix), res))
+			require.Error(t, VerifyRangeProof(cid.Hash, "iavlStoreKey", []byte(tc.prefix+"x"), res))
+
+			// any key omitted from the listing makes the proof invalid
+			for i := range pairs.Pairs {
+				tampered := res
+				omitted := append(append([]kv.Pair{}, pairs.Pairs[:i]...), pairs.Pairs[i+1:]...)
+				var err error
+				tampered.Value, err = (&kv.Pairs{Pairs: omitted}).Marshal()
+				require.NoError(t, err)
+				require.Error(t, VerifyRangeProof(cid.Hash, "iavlStoreKey", []byte(tc.prefix), tampered))
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package rootmulti
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	iavltree "github.com/cosmos/iavl"
+
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/types"
+)
+
+// pendingPruneHeightsKey holds the heights handed to the background pruner which are not fully
+// pruned yet, so they're pruned after a restart.
+var pendingPruneHeightsKey = []byte("s/pendingpruneheights")
+
+// backgroundPruner deletes the pruned versions of the IAVL stores in a background goroutine, so
+// Commit isn't blocked by pruning.
+//
+// The heights are persisted as pending once handed to the pruner, and stay pending until they're
+// deleted from all the stores: the multistore refuses to load pending heights, and the readers of
+// a version lease it beforehand, the pruner waiting for the leases of a version to be released
+// before deleting it, so queries and snapshots never observe a partially pruned version. The
+// deletion of a version from a store is serialized with Commit, which only waits for the deletion
+// of a single store version at most.
+type backgroundPruner struct {
+	rs *Store
+	// interval is the minimum interval between two deletions of a store version, 0 for none.
+	interval time.Duration
+
+	mtx     sync.Mutex
+	pending []int64
+	// leases counts the readers of each version.
+	leases map[int64]int
+	// released is signaled when the last lease of a version is released, or the pruner stops.
+	released *sync.Cond
+	started  bool
+	stopped  bool
+	wake     chan struct{}
+	// stop is closed to stop the pruning goroutine, which closes done once stopped.
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newBackgroundPruner(rs *Store, rateLimit uint64) *backgroundPruner {
+	p := &backgroundPruner{
+		rs:     rs,
+		leases: make(map[int64]int),
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	p.released = sync.NewCond(&p.mtx)
+	if rateLimit > 0 {
+		p.interval = time.Second / time.Duration(rateLimit)
+	}
+	return p
+}
+
+// load resumes the pruning of the heights left pending by a previous run.
+func (p *backgroundPruner) load() error {
+	heights, err := loadPendingPruneHeights(p.rs)
+	if err != nil {
+		return err
+	}
+	return p.add(heights)
+}
+
+// add hands the heights over to the pruner.
+func (p *backgroundPruner) add(heights []int64) error {
+	if len(heights) == 0 {
+		return nil
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	pending := make(map[int64]bool, len(p.pending)+len(heights))
+	for _, height := range append(p.pending, heights...) {
+		pending[height] = true
+	}
+	p.pending = p.pending[:0]
+	for height := range pending {
+		p.pending = append(p.pending, height)
+	}
+	sort.Slice(p.pending, func(i, j int) bool { return p.pending[i] < p.pending[j] })
+
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	if p.stopped {
+		return nil
+	}
+	if !p.started {
+		p.started = true
+		go p.run()
+	}
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// isPending returns whether the height is being pruned.
+func (p *backgroundPruner) isPending(height int64) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.isPendingLocked(height)
+}
+
+// isPendingLocked returns whether the height is being pruned, the caller must hold the lock.
+func (p *backgroundPruner) isPendingLocked(height int64) bool {
+	i := sort.Search(len(p.pending), func(i int) bool { return p.pending[i] >= height })
+	return i < len(p.pending) && p.pending[i] == height
+}
+
+// lease keeps the version at height from being deleted until release is called. It returns an
+// error if the height is already being pruned.
+func (p *backgroundPruner) lease(height int64) (release func(), err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.isPendingLocked(height) {
+		return nil, fmt.Errorf("version %d is being pruned", height)
+	}
+	p.leases[height]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.mtx.Lock()
+			defer p.mtx.Unlock()
+
+			p.leases[height]--
+			if p.leases[height] == 0 {
+				delete(p.leases, height)
+				p.released.Broadcast()
+			}
+		})
+	}, nil
+}
+
+// waitLeases waits until the leases of the version at height are released. It returns false if
+// the pruner was stopped in the meantime.
+func (p *backgroundPruner) waitLeases(height int64) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for p.leases[height] > 0 && !p.stopped {
+		p.released.Wait()
+	}
+	return !p.stopped
+}
+
+// close stops the pruning goroutine, waiting for the deletion of the current store version to
+// end. The heights left pending are resumed on restart.
+func (p *backgroundPruner) close() {
+	p.mtx.Lock()
+	if p.stopped {
+		p.mtx.Unlock()
+		return
+	}
+	p.stopped = true
+	started := p.started
+	close(p.stop)
+	p.released.Broadcast()
+	p.mtx.Unlock()
+
+	if started {
+		<-p.done
+	}
+}
+
+// pendingHeights returns a copy of the pending heights.
+func (p *backgroundPruner) pendingHeights() []int64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return append([]int64{}, p.pending...)
+}
+
+func (p *backgroundPruner) run() {
+	defer close(p.done)
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-p.wake:
+		}
+
+		for {
+			heights := p.pendingHeights()
+			p.rs.metrics.SetGauge(float32(len(heights)), "store", "pruning", "pending_heights")
+			if len(heights) == 0 {
+				break
+			}
+			if err := p.prune(heights[0]); err != nil {
+				if errors.Is(err, errPrunerStopped) {
+					return
+				}
+				// the height stays pending, and is retried when new heights are handed over
+				p.rs.logger.Error("background pruning failed", "height", heights[0], "err", err)
+				break
+			}
+		}
+	}
+}
+
+// errPrunerStopped is returned when the pruner is stopped while pruning a height.
+var errPrunerStopped = errors.New("background pruner stopped")
+
+// prune deletes the version at height from all the IAVL stores, once its leases are released.
+func (p *backgroundPruner) prune(height int64) error {
+	if !p.waitLeases(height) {
+		return errPrunerStopped
+	}
+
+	start := time.Now()
+	p.rs.logger.Debug("background pruning", "height", height)
+
+	for _, key := range p.iavlStoreKeys() {
+		select {
+		case <-p.stop:
+			return errPrunerStopped
+		default:
+		}
+
+		if err := p.pruneStore(key, height); err != nil {
+			return fmt.Errorf("failed to prune store %s: %w", key.Name(), err)
+		}
+		if p.interval > 0 {
+			time.Sleep(p.interval)
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if len(p.pending) > 0 && p.pending[0] == height {
+		p.pending = p.pending[1:]
+	}
+	if err := p.flush(); err != nil {
+		return err
+	}
+
+	p.rs.metrics.IncrCounter(1, "store", "pruning", "pruned_heights")
+	p.rs.metrics.SetGauge(float32(time.Since(start).Seconds()), "store", "pruning", "height_duration_seconds")
+	return nil
+}
+
+// pruneStore deletes the version at height from a store, while holding the commit lock.
+func (p *backgroundPruner) pruneStore(key types.StoreKey, height int64) error {
+	p.rs.commitMtx.Lock()
+	defer p.rs.commitMtx.Unlock()
+
+	store, ok := p.rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		// the store was removed in the meantime
+		return nil
+	}
+	err := store.DeleteVersions(height)
+	if errors.Is(err, iavltree.ErrVersionDoesNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (p *backgroundPruner) iavlStoreKeys() []types.StoreKey {
+	p.rs.commitMtx.Lock()
+	defer p.rs.commitMtx.Unlock()
+
+	var keys []types.StoreKey
+	for _, key := range keysFromStoreKeyMap(p.rs.stores) {
+		if p.rs.stores[key].GetStoreType() == types.StoreTypeIAVL {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// flush persists the pending heights, the caller must hold the lock.
+func (p *backgroundPruner) flush() error {
+	bz := make([]byte, 0, 8*len(p.pending))
+	for _, height := range p.pending {
+		bz = binary.BigEndian.AppendUint64(bz, uint64(height))
+	}
+	return p.rs.db.SetSync(pendingPruneHeightsKey, bz)
+}
+
+func loadPendingPruneHeights(rs *Store) ([]int64, error) {
+	bz, err := rs.db.Get(pendingPruneHeightsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending pruned heights: %w", err)
+	}
+	if len(bz)%8 != 0 {
+		return nil, fmt.Errorf("invalid pending pruned heights")
+	}
+
+	heights := make([]int64, 0, len(bz)/8)
+	for i := 0; i < len(bz); i += 8 {
+		heights = append(heights, int64(binary.BigEndian.Uint64(bz[i:])))
+	}
+	return heights, nil
+}
@@ -225,6 +225,89 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	store1 := source.GetStoreByName("iavl1").(types.CommitKVStore)
+	store2 := source.GetStoreByName("iavl2").(types.CommitKVStore)
+	store3 := source.GetStoreByName("iavl3").(types.CommitKVStore)
+
+	r := rand.New(rand.NewSource(49872768940))
+	for i := 0; i < 1000; i++ {
+		store1.Set([]byte(fmt.Sprintf("key%04d", i)), []byte{byte(r.Intn(256))})
+		store2.Set([]byte(fmt.Sprintf("key%04d", i)), []byte{byte(r.Intn(256))})
+	}
+	source.Commit()
+
+	// store2 is unchanged, and store3 is only written after the base snapshot
+	for i := 0; i < 20; i++ {
+		store1.Set([]byte(fmt.Sprintf("key%04d", r.Intn(1000))), []byte{byte(r.Intn(256))})
+		store1.Delete([]byte(fmt.Sprintf("key%04d", r.Intn(1000))))
+		store3.Set([]byte(fmt.Sprintf("key%04d", i)), []byte{1})
+	}
+	source.Commit()
+
+	store1.Set([]byte("key0500"), []byte{0})
+	store1.Set([]byte("new"), []byte{0})
+	store2.Delete([]byte("key0000"))
+	source.Commit()
+
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(1, 0), source, nil, log.NewNopLogger())
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+	_, err = manager.CreateDelta(2, 1)
+	require.NoError(t, err)
+	delta, err := manager.CreateDelta(3, 2)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.CurrentDeltaFormat, delta.Format)
+	require.EqualValues(t, 2, delta.Metadata.BaseHeight)
+
+	// the delta snapshot only contains the nodes created after its base
+	_, chunks, err := snapshotStore.Load(3, snapshottypes.CurrentDeltaFormat)
+	require.NoError(t, err)
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+	subtrees := 0
+	for {
+		item := snapshottypes.SnapshotItem{}
+		err := streamReader.ReadMsg(&item)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if node := item.GetIAVL(); node != nil {
+			require.EqualValues(t, 3, node.Version)
+		}
+		if item.GetIAVLSubtree() != nil {
+			subtrees++
+		}
+	}
+	require.NoError(t, streamReader.Close())
+	require.Greater(t, subtrees, 2)
+
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	manager = snapshots.NewManager(snapshotStore, snapshottypes.NewSnapshotOptions(1, 0), target, nil, log.NewNopLogger())
+	require.NoError(t, manager.RestoreLocalSnapshot(3, snapshottypes.CurrentDeltaFormat))
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
+func TestMultistoreSnapshotDelta_Errors(t *testing.T) {
+	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+
+	require.Error(t, store.SnapshotDelta(0, 3, nil))
+	require.Error(t, store.SnapshotDelta(3, 3, nil))
+	require.Error(t, store.SnapshotDelta(1, 9, nil))
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -77,11 +77,18 @@ type Store struct {
 	listeners map[types.StoreKey]*types.MemoryListener
 
 	metrics metrics.StoreMetrics
+
+	// commitMtx serializes the commits with the deletions of the background pruner.
+	commitMtx sync.Mutex
+	// pruner deletes the pruned versions in the background, it is nil unless enabled with
+	// SetBackgroundPruning.
+	pruner *backgroundPruner
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore         = (*Store)(nil)
+	_ types.Queryable                = (*Store)(nil)
+	_ snapshottypes.DeltaSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -140,6 +147,18 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetBackgroundPruning enables/disables the deletion of the pruned versions in the background,
+// instead of during Commit, deleting at most rateLimit store versions per second if not zero.
+func (rs *Store) SetBackgroundPruning(enable bool, rateLimit uint64) {
+	if rs.pruner != nil {
+		rs.pruner.close()
+	}
+	rs.pruner = nil
+	if enable {
+		rs.pruner = newBackgroundPruner(rs, rateLimit)
+	}
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -303,7 +322,24 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		return err
 	}
 
-	return nil
+	return rs.loadPendingPruneHeights()
+}
+
+// loadPendingPruneHeights resumes the pruning of the heights left pending by the background
+// pruner, or prunes them right away if background pruning was disabled since.
+func (rs *Store) loadPendingPruneHeights() error {
+	if rs.pruner != nil {
+		return rs.pruner.load()
+	}
+
+	heights, err := loadPendingPruneHeights(rs)
+	if err != nil || len(heights) == 0 {
+		return err
+	}
+	if err := rs.PruneStores(false, heights); err != nil {
+		return err
+	}
+	return rs.db.DeleteSync(pendingPruneHeightsKey)
 }
 
 func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) types.CommitID {
@@ -469,6 +505,7 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
+	rs.commitMtx.Lock()
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
@@ -482,6 +519,7 @@ func (rs *Store) Commit() types.CommitID {
 	}
 	// reset the removalMap
 	rs.removalMap = make(map[types.StoreKey]bool)
+	rs.commitMtx.Unlock()
 
 	if err := rs.handlePruning(version); err != nil {
 		panic(err)
@@ -522,8 +560,13 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights. The version must be leased with LeaseVersion while
+// it is read, when the pruned versions are deleted in the background.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if err := rs.checkNotPruning(version); err != nil {
+		return nil, err
+	}
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
 		var cacheStore types.KVStore
@@ -599,11 +642,50 @@ func (rs *Store) handlePruning(version int64) error {
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
 		return nil
 	}
+	if rs.pruner != nil {
+		heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
+		if err != nil {
+			return err
+		}
+		rs.logger.Debug("handing heights over to the background pruner", "height", version, "heights", heights)
+		return rs.pruner.add(heights)
+	}
+
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
 	return rs.PruneStores(true, nil)
 }
 
+// checkNotPruning returns an error if the version is being pruned in the background, as it may
+// already be deleted from some of the stores.
+func (rs *Store) checkNotPruning(version int64) error {
+	if rs.pruner != nil && rs.pruner.isPending(version) {
+		return fmt.Errorf("version %d is being pruned", version)
+	}
+	return nil
+}
+
+// LeaseVersion keeps the version from being deleted by the background pruner until release is
+// called, returning an error if it is already being pruned. The readers of a past version, such
+// as the multistores returned by CacheMultiStoreWithVersion, must lease it for as long as they
+// read it, or the version may be deleted underneath them.
+func (rs *Store) LeaseVersion(version int64) (release func(), err error) {
+	if rs.pruner == nil {
+		return func() {}, nil
+	}
+	return rs.pruner.lease(version)
+}
+
+// Close stops the background pruner, waiting for the deletion of the current store version to
+// end. The heights it didn't prune yet are resumed when the store is loaded again. The database
+// of the store isn't closed.
+func (rs *Store) Close() error {
+	if rs.pruner != nil {
+		rs.pruner.close()
+	}
+	return nil
+}
+
 // PruneStores prunes the specific heights of the multi store.
 // If clearPruningManager is true, the pruning manager will return the pruning heights,
 // and they are appended to the pruningHeights to be pruned.
@@ -685,6 +767,12 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return types.QueryResult(errorsmod.Wrapf(types.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store), false)
 	}
 
+	release, err := rs.LeaseVersion(req.Height)
+	if err != nil {
+		return types.QueryResult(errorsmod.Wrap(types.ErrInvalidRequest, err.Error()), false)
+	}
+	defer release()
+
 	// trim the path and make the query
 	req.Path = subpath
 	res := queryable.Query(req)
@@ -761,12 +849,33 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return rs.snapshot(0, height, protoWriter)
+}
+
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. The IAVL nodes created after baseHeight
+// are written as in Snapshot, while each maximal subtree which is unchanged since baseHeight is
+// only written as a SnapshotIAVLSubtreeItem referencing its root node.
+func (rs *Store) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid base height %v for delta snapshot at height %v", baseHeight, height)
+	}
+	return rs.snapshot(baseHeight, height, protoWriter)
+}
+
+// snapshot writes the snapshot at height, collapsing the IAVL subtrees unchanged since baseHeight.
+// As node versions are always positive, a zero baseHeight writes a full snapshot.
+func (rs *Store) snapshot(baseHeight, height uint64, protoWriter protoio.Writer) error {
 	if height == 0 {
 		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(GetLatestVersion(rs.db)) {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
+	release, err := rs.LeaseVersion(int64(height))
+	if err != nil {
+		return errorsmod.Wrap(types.ErrLogic, err.Error())
+	}
+	defer release()
 
 	// Collect stores to snapshot (only IAVL stores are supported)
 	type namedStore struct {
@@ -818,6 +927,7 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 				return err
 			}
 
+			subtrees := newSubtreeWriter(protoWriter)
 			nodeCount := 0
 			for {
 				node, err := exporter.Next()
@@ -828,6 +938,19 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 				} else if err != nil {
 					return err
 				}
+
+				// The nodes are exported in post-order, so the subtrees unchanged since
+				// baseHeight are only known to be maximal once a newer node is reached.
+				if node.Version <= int64(baseHeight) {
+					if err := subtrees.add(node); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := subtrees.flush(); err != nil {
+					return err
+				}
+
 				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
 					Item: &snapshottypes.SnapshotItem_IAVL{
 						IAVL: &snapshottypes.SnapshotIAVLItem{
@@ -844,7 +967,7 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 				nodeCount++
 			}
 
-			return nil
+			return subtrees.flush()
 		}()
 
 		if err != nil {
@@ -855,6 +978,55 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	return nil
 }
 
+// subtreeWriter collapses the IAVL nodes of the subtrees unchanged since the base height of a
+// delta snapshot, given in post-order, into SnapshotIAVLSubtreeItem referencing their root.
+type subtreeWriter struct {
+	protoWriter protoio.Writer
+	// pending holds the roots of the unchanged subtrees which may still be part of a larger
+	// unchanged subtree.
+	pending []*snapshottypes.SnapshotIAVLSubtreeItem
+}
+
+func newSubtreeWriter(protoWriter protoio.Writer) *subtreeWriter {
+	return &subtreeWriter{protoWriter: protoWriter}
+}
+
+// add adds an unchanged node, replacing its children subtrees.
+func (w *subtreeWriter) add(node *iavltree.ExportNode) error {
+	subtree := &snapshottypes.SnapshotIAVLSubtreeItem{
+		Key:     node.Key,
+		Version: node.Version,
+		Height:  int32(node.Height),
+		MinKey:  node.Key,
+	}
+	if node.Height > 0 {
+		// the children of an unchanged inner node are unchanged too, and directly precede it.
+		if len(w.pending) < 2 {
+			return errorsmod.Wrapf(types.ErrLogic, "missing children of unchanged node at version %v", node.Version)
+		}
+		subtree.MinKey = w.pending[len(w.pending)-2].MinKey
+		w.pending = w.pending[:len(w.pending)-2]
+	}
+	w.pending = append(w.pending, subtree)
+	return nil
+}
+
+// flush writes the pending subtrees, which are known to be maximal.
+func (w *subtreeWriter) flush() error {
+	for _, subtree := range w.pending {
+		err := w.protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVLSubtree{
+				IAVLSubtree: subtree,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.pending = w.pending[:0]
+	return nil
+}
+
 // Restore implements snapshottypes.Snapshotter.
 // returns next snapshot item and error.
 func (rs *Store) Restore(
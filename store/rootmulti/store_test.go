@@ -3,12 +3,14 @@ package rootmulti
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/store/cachemulti"
@@ -611,6 +613,153 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_BackgroundPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetBackgroundPruning(true, 1000)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	// hold the commit lock so the pruner is stuck on the first height
+	ms.commitMtx.Lock()
+	require.NoError(t, ms.pruner.add([]int64{4, 2, 3}))
+	require.Equal(t, []int64{2, 3, 4}, ms.pruner.pendingHeights())
+
+	// the heights being pruned are neither loaded, queried nor snapshotted
+	_, err := ms.CacheMultiStoreWithVersion(3)
+	require.ErrorContains(t, err, "version 3 is being pruned")
+	res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("key"), Height: 3})
+	require.Contains(t, res.Log, "version 3 is being pruned")
+	err = ms.Snapshot(3, protoio.NewDelimitedWriter(io.Discard))
+	require.ErrorContains(t, err, "version 3 is being pruned")
+
+	// the pending heights are resumed by a restarted store
+	heights, err := loadPendingPruneHeights(ms)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 4}, heights)
+	ms.commitMtx.Unlock()
+
+	require.Eventually(t, func() bool {
+		return len(ms.pruner.pendingHeights()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for _, v := range []int64{2, 3, 4} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+	for _, v := range []int64{1, 5, 10} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err, "expected no error when loading height: %d", v)
+	}
+	heights, err = loadPendingPruneHeights(ms)
+	require.NoError(t, err)
+	require.Empty(t, heights)
+}
+
+func TestMultiStore_BackgroundPruningLease(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetBackgroundPruning(true, 0)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+		ms.Commit()
+	}
+
+	// a reader opens the version 3 before it's handed over to the pruner
+	release, err := ms.LeaseVersion(3)
+	require.NoError(t, err)
+	cms, err := ms.CacheMultiStoreWithVersion(3)
+	require.NoError(t, err)
+	require.NoError(t, ms.pruner.add([]int64{3}))
+
+	_, err = ms.LeaseVersion(3)
+	require.ErrorContains(t, err, "version 3 is being pruned")
+
+	// the version isn't deleted while it's leased
+	require.Never(t, func() bool {
+		return len(ms.pruner.pendingHeights()) == 0
+	}, 200*time.Millisecond, 10*time.Millisecond)
+	require.Equal(t, []byte("value2"), cms.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	release()
+	release()
+	require.Eventually(t, func() bool {
+		return len(ms.pruner.pendingHeights()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = ms.CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+}
+
+func TestMultiStore_BackgroundPruningClose(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetBackgroundPruning(true, 0)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 5; i++ {
+		ms.Commit()
+	}
+
+	// the pruner is stopped while waiting for a leased version
+	release, err := ms.LeaseVersion(2)
+	require.NoError(t, err)
+	defer release()
+	pruner := ms.pruner
+	require.NoError(t, pruner.add([]int64{2}))
+	require.NoError(t, ms.Close())
+
+	select {
+	case <-pruner.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the pruning goroutine didn't stop")
+	}
+
+	// the heights handed over once stopped stay pending, and are resumed on restart
+	require.NoError(t, pruner.add([]int64{3}))
+	heights, err := loadPendingPruneHeights(ms)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, heights)
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetBackgroundPruning(true, 0)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Eventually(t, func() bool {
+		return len(ms.pruner.pendingHeights()) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, ms.Close())
+}
+
+func TestMultiStore_BackgroundPruningRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := int64(0); i < 5; i++ {
+		ms.Commit()
+	}
+
+	// a previous run left heights pending, they're pruned right away when background pruning
+	// was disabled since
+	pruner := newBackgroundPruner(ms, 0)
+	pruner.pending = []int64{2, 3}
+	require.NoError(t, pruner.flush())
+
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for _, v := range []int64{2, 3} {
+		_, err := ms.CacheMultiStoreWithVersion(v)
+		require.Error(t, err, "expected error when loading height: %d", v)
+	}
+	heights, err := loadPendingPruneHeights(ms)
+	require.NoError(t, err)
+	require.Empty(t, heights)
+}
+
 // TestUnevenStoresHeightCheck tests if loading root store correctly errors when
 // there's any module store with the wrong height
 func TestUnevenStoresHeightCheck(t *testing.T) {
@@ -0,0 +1,161 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// deltaReader is a protoio.Reader producing the items of the full snapshot at the height of a
+// delta snapshot, by replacing the IAVL subtrees referenced by the delta snapshot with the nodes
+// of the base snapshot. The base stream must contain the items of a full snapshot, possibly
+// produced by another deltaReader, which allows restoring a chain of delta snapshots.
+//
+// Both streams are read once: the subtrees of a delta snapshot are given in post-order, so they
+// appear in the same order in the base snapshot, where each one is the contiguous sequence of
+// nodes starting at its leftmost leaf and ending at its root.
+type deltaReader struct {
+	base  protoio.Reader
+	delta protoio.Reader
+
+	// baseItem is the next item of the base stream, if it was read but not consumed yet.
+	baseItem *types.SnapshotItem
+	// baseStore is the name of the store the base stream is positioned in.
+	baseStore string
+	// subtree is the subtree of the base snapshot being copied, if any.
+	subtree *types.SnapshotIAVLSubtreeItem
+}
+
+var _ protoio.Reader = (*deltaReader)(nil)
+
+func newDeltaReader(base, delta protoio.Reader) *deltaReader {
+	return &deltaReader{base: base, delta: delta}
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *deltaReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "unexpected message type %T", msg)
+	}
+
+	if r.subtree != nil {
+		return r.readSubtree(item)
+	}
+
+	if err := r.delta.ReadMsg(item); err != nil {
+		return err
+	}
+	switch it := item.Item.(type) {
+	case *types.SnapshotItem_Store:
+		return r.seekStore(it.Store.Name)
+
+	case *types.SnapshotItem_IAVLSubtree:
+		if err := r.seekSubtree(it.IAVLSubtree); err != nil {
+			return err
+		}
+		return r.readSubtree(item)
+	}
+
+	// IAVL nodes and extension items are part of the full snapshot as is.
+	return nil
+}
+
+// nextBase reads the next item of the base stream, returning nil at the end of the stream.
+func (r *deltaReader) nextBase() (*types.SnapshotItem, error) {
+	if r.baseItem != nil {
+		item := r.baseItem
+		r.baseItem = nil
+		return item, nil
+	}
+
+	item := &types.SnapshotItem{}
+	err := r.base.ReadMsg(item)
+	if err == io.EOF {
+		return nil, nil
+	}
+	return item, err
+}
+
+// seekStore positions the base stream at the beginning of the nodes of the given store. Stores
+// are sorted by name, so the base stream doesn't contain the store if a greater store name, or
+// the end of the stores, is reached first.
+func (r *deltaReader) seekStore(name string) error {
+	r.baseStore = ""
+	for {
+		item, err := r.nextBase()
+		if err != nil || item == nil {
+			return err
+		}
+
+		switch it := item.Item.(type) {
+		case *types.SnapshotItem_IAVL:
+			continue
+		case *types.SnapshotItem_Store:
+			if it.Store.Name < name {
+				continue
+			}
+			if it.Store.Name == name {
+				r.baseStore = name
+				return nil
+			}
+		}
+
+		r.baseItem = item
+		return nil
+	}
+}
+
+// seekSubtree positions the base stream at the leftmost leaf of the given subtree, in the
+// current store.
+func (r *deltaReader) seekSubtree(subtree *types.SnapshotIAVLSubtreeItem) error {
+	if r.baseStore == "" {
+		return errorsmod.Wrap(types.ErrInvalidDeltaBase, "base snapshot is missing the store of a subtree")
+	}
+	for {
+		item, err := r.nextBase()
+		if err != nil {
+			return err
+		}
+		node := item.GetIAVL()
+		if node == nil {
+			return errorsmod.Wrapf(types.ErrInvalidDeltaBase,
+				"base snapshot of store %s is missing the subtree at version %v", r.baseStore, subtree.Version)
+		}
+		if node.Height == 0 && bytes.Equal(node.Key, subtree.MinKey) {
+			r.baseItem = item
+			r.subtree = subtree
+			return nil
+		}
+	}
+}
+
+// readSubtree reads the next node of the subtree being copied from the base stream.
+func (r *deltaReader) readSubtree(item *types.SnapshotItem) error {
+	baseItem, err := r.nextBase()
+	if err != nil {
+		return err
+	}
+	node := baseItem.GetIAVL()
+	if node == nil {
+		return errorsmod.Wrapf(types.ErrInvalidDeltaBase,
+			"base snapshot of store %s ended in the subtree at version %v", r.baseStore, r.subtree.Version)
+	}
+
+	if node.Height == r.subtree.Height && bytes.Equal(node.Key, r.subtree.Key) {
+		if node.Version != r.subtree.Version {
+			return errorsmod.Wrapf(types.ErrInvalidDeltaBase,
+				"subtree of store %s has version %v in the base snapshot, expected %v", r.baseStore, node.Version, r.subtree.Version)
+		}
+		r.subtree = nil
+	}
+
+	*item = *baseItem
+	return nil
+}
@@ -171,6 +171,15 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockDeltaSnapshotter is a mockSnapshotter writing its items in delta snapshots as well.
+type mockDeltaSnapshotter struct {
+	*mockSnapshotter
+}
+
+func (m mockDeltaSnapshotter) SnapshotDelta(_, height uint64, protoWriter protoio.Writer) error {
+	return m.Snapshot(height, protoWriter)
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	"cosmossdk.io/log"
+	protoio "github.com/cosmos/gogoproto/io"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/snapshots/types"
@@ -159,6 +160,21 @@ func (m *Manager) GetSnapshotBlockRetentionHeights() int64 {
 
 // Create creates a snapshot and returns its metadata.
 func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
+	return m.create(0, height)
+}
+
+// CreateDelta creates a delta snapshot at height on top of the snapshot at baseHeight, which must
+// exist in the snapshot store, and returns its metadata. The multistore must implement
+// types.DeltaSnapshotter.
+func (m *Manager) CreateDelta(height, baseHeight uint64) (*types.Snapshot, error) {
+	if baseHeight == 0 {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "delta snapshot base height cannot be 0")
+	}
+	return m.create(baseHeight, height)
+}
+
+// create creates a snapshot at height, which is a delta snapshot if baseHeight is not 0.
+func (m *Manager) create(baseHeight, height uint64) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
 	}
@@ -180,16 +196,32 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if baseHeight > 0 {
+		if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+			return nil, errorsmod.Wrap(storetypes.ErrLogic, "multistore doesn't support delta snapshots")
+		}
+		base, err := m.store.getBase(baseHeight)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDeltaBase, "no snapshot exists at height %v", baseHeight)
+		}
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(baseHeight, height, ch)
 
+	if baseHeight > 0 {
+		return m.store.SaveDelta(height, baseHeight, ch)
+	}
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+func (m *Manager) createSnapshot(baseHeight, height uint64, ch chan<- io.ReadCloser) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -200,7 +232,13 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	var err error
+	if baseHeight > 0 {
+		err = m.multistore.(types.DeltaSnapshotter).SnapshotDelta(baseHeight, height, streamWriter)
+	} else {
+		err = m.multistore.Snapshot(height, streamWriter)
+	}
+	if err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -312,14 +350,78 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
-	var nextItem types.SnapshotItem
-
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
+	return m.restoreStream(snapshot.Height, streamReader)
+}
+
+// RestoreLocalSnapshot restores the state from the snapshot at height with the given format in the
+// snapshot store. A delta snapshot is restored on top of its chain of base snapshots, which must
+// all exist in the snapshot store.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, err := m.store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for chain[0].Format == types.CurrentDeltaFormat {
+		base, err := m.store.getBase(chain[0].Metadata.BaseHeight)
+		if err != nil {
+			return err
+		}
+		if base == nil {
+			return errorsmod.Wrapf(types.ErrInvalidDeltaBase, "no snapshot exists at height %v, base of the snapshot at height %v",
+				chain[0].Metadata.BaseHeight, chain[0].Height)
+		}
+		chain = append([]*types.Snapshot{base}, chain...)
+	}
+	if err := ValidRestoreHeight(chain[0].Format, height); err != nil {
+		return err
+	}
+
+	err = m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	// The stream of a delta snapshot is merged with the stream of the full snapshot at the height
+	// of its base, giving the stream of the full snapshot at its height.
+	var reader protoio.Reader
+	for _, s := range chain {
+		_, chChunks, err := m.store.Load(s.Height, s.Format)
+		if err != nil {
+			return err
+		}
+		streamReader, err := NewStreamReader(chChunks)
+		if err != nil {
+			DrainChunks(chChunks)
+			return err
+		}
+		defer streamReader.Close()
+
+		if reader == nil {
+			reader = streamReader
+		} else {
+			reader = newDeltaReader(reader, streamReader)
+		}
+	}
+
+	return m.restoreStream(height, reader)
+}
+
+// restoreStream restores the state from the items of a full snapshot at height.
+func (m *Manager) restoreStream(height uint64, streamReader protoio.Reader) error {
+	var nextItem types.SnapshotItem
+
 	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
 	payloadReader := func() ([]byte, error) {
 		nextItem.Reset()
@@ -333,7 +435,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	nextItem, err := m.multistore.Restore(height, types.CurrentFormat, streamReader)
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -355,7 +457,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 			return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return errorsmod.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
@@ -465,13 +567,24 @@ func (m *Manager) snapshot(height int64) {
 		return
 	}
 
-	snapshot, err := m.Create(uint64(height))
+	baseHeight, err := m.deltaBaseHeight()
+	if err != nil {
+		m.logger.Error("failed to find delta snapshot base", "height", height, "err", err)
+		return
+	}
+
+	var snapshot *types.Snapshot
+	if baseHeight > 0 {
+		snapshot, err = m.CreateDelta(uint64(height), baseHeight)
+	} else {
+		snapshot, err = m.Create(uint64(height))
+	}
 	if err != nil {
 		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
 		return
 	}
 
-	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format, "base_height", snapshot.Metadata.BaseHeight)
 
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
@@ -485,3 +598,36 @@ func (m *Manager) snapshot(height int64) {
 		m.logger.Debug("pruned state snapshots", "pruned", pruned)
 	}
 }
+
+// deltaBaseHeight returns the height of the latest snapshot if the next snapshot should be a delta
+// snapshot on top of it, i.e. if it is part of a chain of less than MaxDeltas delta snapshots on
+// top of a full snapshot. It returns 0 otherwise.
+func (m *Manager) deltaBaseHeight() (uint64, error) {
+	if m.opts.MaxDeltas == 0 {
+		return 0, nil
+	}
+	if _, ok := m.multistore.(types.DeltaSnapshotter); !ok {
+		return 0, nil
+	}
+
+	latest, err := m.store.GetLatest()
+	if err != nil || latest == nil {
+		return 0, err
+	}
+
+	deltas := uint32(0)
+	for snapshot := latest; snapshot.Format != types.CurrentFormat; deltas++ {
+		if snapshot.Format != types.CurrentDeltaFormat || deltas >= m.opts.MaxDeltas {
+			return 0, nil
+		}
+		snapshot, err = m.store.getBase(snapshot.Metadata.BaseHeight)
+		if err != nil || snapshot == nil {
+			return 0, err
+		}
+	}
+	if deltas >= m.opts.MaxDeltas {
+		return 0, nil
+	}
+
+	return latest.Height, nil
+}
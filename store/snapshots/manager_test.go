@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -112,6 +113,53 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_TakeDelta(t *testing.T) {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	snapshotter := &mockSnapshotter{
+		items:         [][]byte{{1, 2, 3}},
+		prunedHeights: make(map[int64]struct{}),
+	}
+
+	// the multistore must support delta snapshots
+	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
+	_, err = manager.Create(1)
+	require.NoError(t, err)
+	_, err = manager.CreateDelta(2, 1)
+	require.Error(t, err)
+
+	deltaOpts := types.NewSnapshotOptions(1, 0)
+	deltaOpts.MaxDeltas = 2
+	manager = snapshots.NewManager(store, deltaOpts, mockDeltaSnapshotter{snapshotter}, nil, log.NewNopLogger())
+
+	// the base snapshot must exist
+	_, err = manager.CreateDelta(2, 0)
+	require.Error(t, err)
+	_, err = manager.CreateDelta(3, 2)
+	require.ErrorIs(t, err, types.ErrInvalidDeltaBase)
+
+	// MaxDeltas delta snapshots are taken on top of each full snapshot
+	for height := int64(2); height <= 5; height++ {
+		manager.SnapshotIfApplicable(height)
+	}
+	list, err := manager.List()
+	require.NoError(t, err)
+	formats := map[uint64]uint32{}
+	bases := map[uint64]uint64{}
+	for _, snapshot := range list {
+		formats[snapshot.Height] = snapshot.Format
+		bases[snapshot.Height] = snapshot.Metadata.BaseHeight
+	}
+	require.Equal(t, map[uint64]uint32{
+		1: types.CurrentFormat,
+		2: types.CurrentDeltaFormat,
+		3: types.CurrentDeltaFormat,
+		4: types.CurrentFormat,
+		5: types.CurrentDeltaFormat,
+	}, formats)
+	require.Equal(t, map[uint64]uint64{1: 0, 2: 1, 3: 2, 4: 0, 5: 4}, bases)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	snapshotter := &mockSnapshotter{}
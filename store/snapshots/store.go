@@ -181,7 +181,8 @@ func (s *Store) loadChunkFile(height uint64, format uint32, chunk uint32) (io.Re
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained,
+// along with the chain of base snapshots of the retained delta snapshots.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
 	if err != nil {
@@ -192,6 +193,7 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	bases := make(map[uint64]bool)
 	for ; iter.Valid(); iter.Next() {
 		height, format, err := decodeKey(iter.Key())
 		if err != nil {
@@ -199,6 +201,17 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		}
 		if skip[height] || uint32(len(skip)) < retain {
 			skip[height] = true
+		}
+		if skip[height] || bases[height] {
+			// snapshots are iterated from the newest, so the bases of a retained delta snapshot
+			// are always iterated after it.
+			snapshot := &types.Snapshot{}
+			if err := proto.Unmarshal(iter.Value(), snapshot); err != nil {
+				return 0, errors.Wrap(err, "failed to decode snapshot info")
+			}
+			if snapshot.Metadata.BaseHeight > 0 {
+				bases[snapshot.Metadata.BaseHeight] = true
+			}
 			continue
 		}
 		err = s.Delete(height, format)
@@ -221,9 +234,36 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	return pruned, iter.Error()
 }
 
+// getBase fetches the snapshot at height which can be used as the base of a delta snapshot, i.e.
+// either a full or a delta snapshot in the current formats, if any.
+func (s *Store) getBase(height uint64) (*types.Snapshot, error) {
+	snapshot, err := s.Get(height, types.CurrentFormat)
+	if snapshot != nil || err != nil {
+		return snapshot, err
+	}
+	return s.Get(height, types.CurrentDeltaFormat)
+}
+
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, 0, chunks)
+}
+
+// SaveDelta saves a delta snapshot on top of the snapshot at baseHeight to disk, returning it.
+func (s *Store) SaveDelta(
+	height, baseHeight uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if baseHeight == 0 || baseHeight >= height {
+		DrainChunks(chunks)
+		return nil, errors.Wrapf(storetypes.ErrLogic, "invalid base height %v for delta snapshot at height %v", baseHeight, height)
+	}
+	return s.save(height, types.CurrentDeltaFormat, baseHeight, chunks)
+}
+
+func (s *Store) save(
+	height uint64, format uint32, baseHeight uint64, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -256,6 +296,9 @@ func (s *Store) Save(
 	snapshot := &types.Snapshot{
 		Height: height,
 		Format: format,
+		Metadata: types.Metadata{
+			BaseHeight: baseHeight,
+		},
 	}
 
 	dirCreated := false
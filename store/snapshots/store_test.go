@@ -261,6 +261,40 @@ func TestStore_Prune(t *testing.T) {
 	assert.Empty(t, snapshots)
 }
 
+func TestStore_PruneDeltas(t *testing.T) {
+	store := setupStore(t)
+	_, err := store.SaveDelta(4, 3, makeChunks([][]byte{{4, 0}}))
+	require.NoError(t, err)
+	_, err = store.SaveDelta(5, 4, makeChunks([][]byte{{5, 0}}))
+	require.NoError(t, err)
+
+	// the chain of bases of the retained delta snapshot is retained
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, pruned)
+
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Equal(t, []*types.Snapshot{
+		{
+			Height: 5, Format: types.CurrentDeltaFormat, Chunks: 1, Hash: hash([][]byte{{5, 0}}),
+			Metadata: types.Metadata{ChunkHashes: checksums([][]byte{{5, 0}}), BaseHeight: 4},
+		},
+		{
+			Height: 4, Format: types.CurrentDeltaFormat, Chunks: 1, Hash: hash([][]byte{{4, 0}}),
+			Metadata: types.Metadata{ChunkHashes: checksums([][]byte{{4, 0}}), BaseHeight: 3},
+		},
+		{
+			Height: 3, Format: 2, Chunks: 3, Hash: hash([][]byte{{3, 2, 0}, {3, 2, 1}, {3, 2, 2}}),
+			Metadata: types.Metadata{ChunkHashes: checksums([][]byte{{3, 2, 0}, {3, 2, 1}, {3, 2, 2}})},
+		},
+	}, snapshots)
+
+	// the base height must be lower than the height
+	_, err = store.SaveDelta(6, 6, makeChunks(nil))
+	require.Error(t, err)
+}
+
 func TestStore_Save(t *testing.T) {
 	store := setupStore(t)
 	// Saving a snapshot should work
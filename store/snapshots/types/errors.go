@@ -16,4 +16,7 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrInvalidDeltaBase is returned when a delta snapshot doesn't match its base snapshot.
+	ErrInvalidDeltaBase = errors.New("invalid delta snapshot base")
 )
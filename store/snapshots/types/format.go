@@ -4,3 +4,10 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// CurrentDeltaFormat is the currently used format for delta snapshots. A delta snapshot only
+// contains the IAVL nodes created since its base snapshot, the unchanged subtrees being referenced
+// by their root node. It is derived from CurrentFormat so that it is bumped along with it.
+//
+// Delta snapshots can't be restored through state sync, but only locally on top of their base.
+const CurrentDeltaFormat uint32 = 1000 + CurrentFormat
@@ -26,6 +26,16 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DeltaSnapshotter is a Snapshotter which can also create delta snapshots.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDelta writes the snapshot items of a delta snapshot at height into the protobuf
+	// writer. Only the IAVL nodes created after baseHeight are written, the subtrees unchanged
+	// since baseHeight are written as SnapshotIAVLSubtreeItem.
+	SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
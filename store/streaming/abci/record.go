@@ -0,0 +1,280 @@
+package abci
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// RecordType identifies the message of a record written by a RecordListener.
+type RecordType byte
+
+const (
+	// RecordBeginBlock records hold a ListenBeginBlockRequest.
+	RecordBeginBlock RecordType = iota + 1
+	// RecordDeliverTx records hold a ListenDeliverTxRequest.
+	RecordDeliverTx
+	// RecordEndBlock records hold a ListenEndBlockRequest.
+	RecordEndBlock
+	// RecordStoreKVPair records hold a StoreKVPair of the state changes of the block.
+	RecordStoreKVPair
+	// RecordCommit records hold a ListenCommitRequest, without its change set.
+	RecordCommit
+)
+
+var recordTypeNames = map[RecordType]string{
+	RecordBeginBlock:  "begin_block",
+	RecordDeliverTx:   "deliver_tx",
+	RecordEndBlock:    "end_block",
+	RecordStoreKVPair: "store_kv_pair",
+	RecordCommit:      "commit",
+}
+
+// String returns the snake case name of the record type.
+func (t RecordType) String() string {
+	if name, ok := recordTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown_%d", byte(t))
+}
+
+// NewRecordMessage returns an empty message of the type held by records of the given type.
+func NewRecordMessage(t RecordType) (proto.Message, error) {
+	switch t {
+	case RecordBeginBlock:
+		return &ListenBeginBlockRequest{}, nil
+	case RecordDeliverTx:
+		return &ListenDeliverTxRequest{}, nil
+	case RecordEndBlock:
+		return &ListenEndBlockRequest{}, nil
+	case RecordStoreKVPair:
+		return &storetypes.StoreKVPair{}, nil
+	case RecordCommit:
+		return &ListenCommitRequest{}, nil
+	default:
+		return nil, fmt.Errorf("unknown record type %d", byte(t))
+	}
+}
+
+// RecordWriter is the output of a RecordListener.
+type RecordWriter interface {
+	// WriteRecord writes a record of the block at height.
+	WriteRecord(height int64, t RecordType, msg proto.Message) error
+	// Commit is called once all the records of the block at height were written, including the
+	// commit record.
+	Commit(height int64) error
+}
+
+// RecordListener is an ABCIListener converting the ABCI messages and the state changes of each
+// block into records, written in order to a RecordWriter: the BeginBlock record, a DeliverTx
+// record per transaction, the EndBlock record, a StoreKVPair record per state change and
+// finally the Commit record.
+type RecordListener struct {
+	writer RecordWriter
+	// storeKeys are the names of the stores whose changes are recorded, all stores if nil.
+	storeKeys map[string]bool
+	// stopNodeOnErr makes the node exit when a record can't be written.
+	stopNodeOnErr bool
+	// height is the height of the current block.
+	height int64
+
+	// offsetFile is the file the height of the last delivered block is persisted in, if any.
+	offsetFile string
+	// lastDelivered is the height of the last delivered block.
+	lastDelivered int64
+}
+
+var _ storetypes.ResumableABCIListener = (*RecordListener)(nil)
+
+// NewRecordListener returns a RecordListener writing to w, recording the changes of all the
+// stores listened to.
+func NewRecordListener(w RecordWriter) *RecordListener {
+	return &RecordListener{writer: w}
+}
+
+// WithStoreKeys restricts the recorded state changes to the stores with the given names.
+func (l *RecordListener) WithStoreKeys(storeKeys ...string) *RecordListener {
+	l.storeKeys = make(map[string]bool, len(storeKeys))
+	for _, key := range storeKeys {
+		l.storeKeys[key] = true
+	}
+	return l
+}
+
+// WithStopNodeOnErr sets whether the node exits when a record can't be written, instead of only
+// logging the error and skipping the record.
+func (l *RecordListener) WithStopNodeOnErr(stopNodeOnErr bool) *RecordListener {
+	l.stopNodeOnErr = stopNodeOnErr
+	return l
+}
+
+// WithOffsetFile persists the height of the last block whose records were committed by the
+// writer in the file at path, loading the height persisted by a previous run, so the blocks
+// missed after an error or a restart can be replayed to the listener.
+func (l *RecordListener) WithOffsetFile(path string) (*RecordListener, error) {
+	bz, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		l.lastDelivered = 0
+	case err != nil:
+		return nil, err
+	default:
+		l.lastDelivered, err = strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid streaming offset file %s: %w", path, err)
+		}
+	}
+
+	l.offsetFile = path
+	return l, nil
+}
+
+// LastDeliveredHeight implements ResumableABCIListener interface. It always returns 0 if the
+// listener has no offset file.
+func (l *RecordListener) LastDeliveredHeight() int64 {
+	return l.lastDelivered
+}
+
+// ListenBeginBlock implements ABCIListener interface
+func (l *RecordListener) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.height = req.Header.Height
+	err := l.writer.WriteRecord(l.height, RecordBeginBlock, &ListenBeginBlockRequest{Req: &req, Res: &res})
+	return l.checkErr(ctx, "BeginBlock", err)
+}
+
+// ListenDeliverTx implements ABCIListener interface
+func (l *RecordListener) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	height := l.blockHeight(ctx)
+	err := l.writer.WriteRecord(height, RecordDeliverTx, &ListenDeliverTxRequest{BlockHeight: height, Req: &req, Res: &res})
+	return l.checkErr(ctx, "DeliverTx", err)
+}
+
+// ListenEndBlock implements ABCIListener interface
+func (l *RecordListener) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	err := l.writer.WriteRecord(l.blockHeight(ctx), RecordEndBlock, &ListenEndBlockRequest{Req: &req, Res: &res})
+	return l.checkErr(ctx, "EndBlock", err)
+}
+
+// ListenCommit implements ABCIListener interface
+func (l *RecordListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	return l.checkErr(ctx, "Commit", l.writeCommit(l.blockHeight(ctx), res, changeSet))
+}
+
+func (l *RecordListener) writeCommit(height int64, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	for _, pair := range changeSet {
+		if l.storeKeys != nil && !l.storeKeys[pair.StoreKey] {
+			continue
+		}
+		if err := l.writer.WriteRecord(height, RecordStoreKVPair, pair); err != nil {
+			return err
+		}
+	}
+	if err := l.writer.WriteRecord(height, RecordCommit, &ListenCommitRequest{BlockHeight: height, Res: &res}); err != nil {
+		return err
+	}
+	if err := l.writer.Commit(height); err != nil {
+		return err
+	}
+	return l.ack(height)
+}
+
+// ack persists the height of the last delivered block in the offset file, by atomically
+// replacing it.
+func (l *RecordListener) ack(height int64) error {
+	if l.offsetFile == "" {
+		return nil
+	}
+
+	tmp := l.offsetFile + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)), 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, l.offsetFile); err != nil {
+		return err
+	}
+
+	l.lastDelivered = height
+	return nil
+}
+
+// checkErr makes the node exit on errors when the listener is configured to stop the node on
+// errors, like the gRPC plugin client does.
+func (l *RecordListener) checkErr(ctx context.Context, hook string, err error) error {
+	if err != nil && l.stopNodeOnErr {
+		if sdkCtx, ok := ctx.(storetypes.Context); ok {
+			sdkCtx.Logger().Error(hook+" listening hook failed", "height", sdkCtx.BlockHeight(), "err", err)
+		}
+		os.Exit(1)
+	}
+	return err
+}
+
+// blockHeight returns the height of the block from the context given to the listener, falling
+// back to the height of the last BeginBlock.
+func (l *RecordListener) blockHeight(ctx context.Context) int64 {
+	if sdkCtx, ok := ctx.(storetypes.Context); ok && sdkCtx.BlockHeight() > 0 {
+		return sdkCtx.BlockHeight()
+	}
+	return l.height
+}
+
+// WriteDelimitedRecord writes a record as its type byte, followed by the uvarint length of the
+// encoded message and the encoded message.
+func WriteDelimitedRecord(w io.Writer, t RecordType, msg proto.Message) (int, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(bz))
+	buf[0] = byte(t)
+	n := binary.PutUvarint(buf[1:], uint64(len(bz)))
+	buf = append(buf[:1+n], bz...)
+
+	return w.Write(buf)
+}
+
+// ReadDelimitedRecord reads a record written by WriteDelimitedRecord, returning io.EOF at the
+// end of the stream.
+func ReadDelimitedRecord(r *bufio.Reader) (RecordType, proto.Message, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	t := RecordType(b)
+	msg, err := NewRecordMessage(t)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, unexpectedEOF(err)
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return 0, nil, unexpectedEOF(err)
+	}
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return 0, nil, err
+	}
+
+	return t, msg, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
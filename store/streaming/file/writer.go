@@ -0,0 +1,239 @@
+// Package file implements an in-process ABCI listener appending the streamed blocks to rotating
+// local files.
+package file
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+)
+
+// FsyncPolicy defines when the written records are synced to stable storage.
+type FsyncPolicy string
+
+const (
+	// FsyncNone leaves syncing the files to the operating system.
+	FsyncNone FsyncPolicy = "none"
+	// FsyncCommit syncs the file once all the records of a block are written.
+	FsyncCommit FsyncPolicy = "commit"
+	// FsyncAlways syncs the file after each record.
+	FsyncAlways FsyncPolicy = "always"
+)
+
+const (
+	// DefaultPrefix is the default prefix of the file names.
+	DefaultPrefix = "block"
+	// DefaultMaxFileSize is the default size after which a new file is started.
+	DefaultMaxFileSize = 100 << 20
+
+	fileExt = ".bin"
+)
+
+// Options are the options of a Writer.
+type Options struct {
+	// Dir is the directory the files are written to, created if missing.
+	Dir string
+	// Prefix is the prefix of the file names, DefaultPrefix if empty.
+	Prefix string
+	// MaxFileSize is the size in bytes after which a new file is started, at the next block.
+	// DefaultMaxFileSize if zero.
+	MaxFileSize int64
+	// Fsync is the fsync policy, FsyncCommit if empty.
+	Fsync FsyncPolicy
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.Dir == "" {
+		return fmt.Errorf("streaming file directory must be set")
+	}
+	if o.MaxFileSize < 0 {
+		return fmt.Errorf("invalid streaming max file size %d", o.MaxFileSize)
+	}
+	switch o.Fsync {
+	case "", FsyncNone, FsyncCommit, FsyncAlways:
+		return nil
+	default:
+		return fmt.Errorf("unknown streaming fsync policy %q", o.Fsync)
+	}
+}
+
+// Writer is a streamingabci.RecordWriter appending length-prefixed records to files named
+// <prefix>-<height>.bin, where height is the height of the first block of the file. Files only
+// contain whole blocks: a new file is started at the first block following the one which made
+// the current file exceed the maximum file size.
+type Writer struct {
+	opts Options
+
+	mtx  sync.Mutex
+	file *os.File
+	buf  *bufio.Writer
+	size int64
+}
+
+var _ streamingabci.RecordWriter = (*Writer)(nil)
+
+// NewWriter returns a Writer with the given options.
+func NewWriter(opts Options) (*Writer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = DefaultPrefix
+	}
+	if opts.MaxFileSize == 0 {
+		opts.MaxFileSize = DefaultMaxFileSize
+	}
+	if opts.Fsync == "" {
+		opts.Fsync = FsyncCommit
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Writer{opts: opts}, nil
+}
+
+// NewListener returns an ABCIListener writing to files with the given options, along with its
+// Writer.
+func NewListener(opts Options) (*streamingabci.RecordListener, *Writer, error) {
+	w, err := NewWriter(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return streamingabci.NewRecordListener(w), w, nil
+}
+
+// WriteRecord implements streamingabci.RecordWriter interface
+func (w *Writer) WriteRecord(height int64, t streamingabci.RecordType, msg proto.Message) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.file == nil {
+		if err := w.open(height); err != nil {
+			return err
+		}
+	}
+
+	n, err := streamingabci.WriteDelimitedRecord(w.buf, t, msg)
+	w.size += int64(n)
+	if err != nil {
+		return err
+	}
+
+	if w.opts.Fsync == FsyncAlways {
+		return w.sync()
+	}
+	return nil
+}
+
+// Commit implements streamingabci.RecordWriter interface
+func (w *Writer) Commit(_ int64) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if w.opts.Fsync != FsyncNone {
+		if err := w.file.Sync(); err != nil {
+			return err
+		}
+	}
+
+	if w.size >= w.opts.MaxFileSize {
+		return w.closeFile()
+	}
+	return nil
+}
+
+// Close flushes and closes the current file.
+func (w *Writer) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	if err := w.buf.Flush(); err != nil {
+		_ = w.file.Close()
+		w.file = nil
+		return err
+	}
+	return w.closeFile()
+}
+
+func (w *Writer) open(height int64) error {
+	path := filepath.Join(w.opts.Dir, FileName(w.opts.Prefix, height))
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	w.file = file
+	w.buf = bufio.NewWriter(file)
+	w.size = info.Size()
+	return nil
+}
+
+func (w *Writer) sync() error {
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+func (w *Writer) closeFile() error {
+	err := w.file.Close()
+	w.file = nil
+	w.buf = nil
+	w.size = 0
+	return err
+}
+
+// FileName returns the name of the file starting at the block at height.
+func FileName(prefix string, height int64) string {
+	return fmt.Sprintf("%s-%020d%s", prefix, height, fileExt)
+}
+
+// ListFiles returns the paths of the files with the given prefix in dir, in block order.
+func ListFiles(dir, prefix string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		height, ok := strings.CutSuffix(strings.TrimPrefix(name, prefix+"-"), fileExt)
+		if entry.IsDir() || !ok || !strings.HasPrefix(name, prefix+"-") {
+			continue
+		}
+		if _, err := strconv.ParseInt(height, 10, 64); err != nil {
+			continue
+		}
+		files = append(files, filepath.Join(dir, name))
+	}
+	// heights are zero padded, so the names sort in block order
+	sort.Strings(files)
+
+	return files, nil
+}
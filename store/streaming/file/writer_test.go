@@ -0,0 +1,143 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func listenBlock(t *testing.T, listener storetypes.ABCIListener, height int64, changeSet []*storetypes.StoreKVPair) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, listener.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, listener.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{Code: 1}))
+	require.NoError(t, listener.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{RetainHeight: height}, changeSet))
+}
+
+func readRecords(t *testing.T, path string) []streamingabci.RecordType {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var types []streamingabci.RecordType
+	r := bufio.NewReader(f)
+	for {
+		typ, _, err := streamingabci.ReadDelimitedRecord(r)
+		if err == io.EOF {
+			return types
+		}
+		require.NoError(t, err)
+		types = append(types, typ)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	dir := t.TempDir()
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "acc", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "bank", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "acc", Key: []byte("c"), Delete: true},
+	}
+
+	// every block exceeds the max file size, so each one is written to a new file
+	listener, w, err := NewListener(Options{Dir: dir, MaxFileSize: 1, Fsync: FsyncAlways})
+	require.NoError(t, err)
+	listener.WithStoreKeys("acc")
+	listenBlock(t, listener, 1, changeSet)
+	listenBlock(t, listener, 2, nil)
+	require.NoError(t, w.Close())
+
+	files, err := ListFiles(dir, DefaultPrefix)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, FileName(DefaultPrefix, 1)),
+		filepath.Join(dir, FileName(DefaultPrefix, 2)),
+	}, files)
+
+	require.Equal(t, []streamingabci.RecordType{
+		streamingabci.RecordBeginBlock,
+		streamingabci.RecordDeliverTx,
+		streamingabci.RecordEndBlock,
+		streamingabci.RecordStoreKVPair,
+		streamingabci.RecordStoreKVPair,
+		streamingabci.RecordCommit,
+	}, readRecords(t, files[0]))
+
+	f, err := os.Open(files[0])
+	require.NoError(t, err)
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for i := 0; i < 3; i++ {
+		_, _, err := streamingabci.ReadDelimitedRecord(r)
+		require.NoError(t, err)
+	}
+	_, msg, err := streamingabci.ReadDelimitedRecord(r)
+	require.NoError(t, err)
+	require.Equal(t, changeSet[0], msg)
+	_, msg, err = streamingabci.ReadDelimitedRecord(r)
+	require.NoError(t, err)
+	require.Equal(t, changeSet[2], msg)
+	_, msg, err = streamingabci.ReadDelimitedRecord(r)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), msg.(*streamingabci.ListenCommitRequest).BlockHeight)
+
+	// the files are appended to, so a block replayed after a restart is written again
+	listener, w, err = NewListener(Options{Dir: dir, Fsync: FsyncNone})
+	require.NoError(t, err)
+	listenBlock(t, listener, 2, changeSet)
+	listenBlock(t, listener, 3, nil)
+	require.NoError(t, w.Close())
+
+	files, err = ListFiles(dir, DefaultPrefix)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.Len(t, readRecords(t, files[1]), 4+7+4)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	require.NoError(t, Options{Dir: "data"}.Validate())
+	require.NoError(t, Options{Dir: "data", Fsync: FsyncAlways, MaxFileSize: 10}.Validate())
+	require.Error(t, Options{}.Validate())
+	require.Error(t, Options{Dir: "data", MaxFileSize: -1}.Validate())
+	require.Error(t, Options{Dir: "data", Fsync: "sometimes"}.Validate())
+}
+
+func TestListenerOffset(t *testing.T) {
+	dir := t.TempDir()
+	offsetFile := filepath.Join(dir, DefaultPrefix+".offset")
+
+	listener, w, err := NewListener(Options{Dir: dir})
+	require.NoError(t, err)
+	_, err = listener.WithOffsetFile(offsetFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), listener.LastDeliveredHeight())
+
+	listenBlock(t, listener, 1, nil)
+	listenBlock(t, listener, 2, nil)
+	require.Equal(t, int64(2), listener.LastDeliveredHeight())
+	require.NoError(t, w.Close())
+
+	// the offset is loaded on restart
+	listener, w, err = NewListener(Options{Dir: dir})
+	require.NoError(t, err)
+	_, err = listener.WithOffsetFile(offsetFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), listener.LastDeliveredHeight())
+	require.NoError(t, w.Close())
+
+	require.NoError(t, os.WriteFile(offsetFile, []byte("invalid"), 0o600))
+	_, err = listener.WithOffsetFile(offsetFile)
+	require.Error(t, err)
+}
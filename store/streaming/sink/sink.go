@@ -0,0 +1,125 @@
+// Package sink implements an in-process ABCI listener publishing the streamed blocks to a Sink,
+// such as a message broker producer.
+package sink
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+)
+
+// DefaultTopicPrefix is the default prefix of the topics records are published to.
+const DefaultTopicPrefix = "cosmos"
+
+// Sink is the destination of the streamed records, typically a Kafka-style producer.
+type Sink interface {
+	// Send publishes a message with the given key to a topic. The message may be buffered until
+	// the next Flush.
+	Send(ctx context.Context, topic string, key, value []byte) error
+	// Flush blocks until all the messages sent were delivered.
+	Flush(ctx context.Context) error
+	// Close flushes the pending messages and releases the resources of the sink.
+	Close() error
+}
+
+// Constructor creates a Sink from the options given in the app.toml configuration.
+type Constructor func(options map[string]string) (Sink, error)
+
+var (
+	mtx          sync.RWMutex
+	constructors = map[string]Constructor{}
+)
+
+// Register registers a Sink constructor under the given name, so it can be selected in the
+// app.toml configuration. It panics if a constructor is already registered under the name.
+func Register(name string, constructor Constructor) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	if _, ok := constructors[name]; ok {
+		panic(fmt.Sprintf("streaming sink %s is already registered", name))
+	}
+	constructors[name] = constructor
+}
+
+// New creates the Sink registered under the given name.
+func New(name string, options map[string]string) (Sink, error) {
+	mtx.RLock()
+	constructor, ok := constructors[name]
+	mtx.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown streaming sink %q, registered sinks: %v", name, Registered())
+	}
+	return constructor(options)
+}
+
+// Registered returns the sorted names of the registered sinks.
+func Registered() []string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Writer is a streamingabci.RecordWriter sending records to a Sink. Each record is published to
+// the topic <prefix>.<record type>, such as cosmos.store_kv_pair, with the big-endian block
+// height as key, so the records of a block stay ordered on partitioned topics. The sink is
+// flushed once all the records of a block were sent.
+type Writer struct {
+	sink        Sink
+	topicPrefix string
+}
+
+var _ streamingabci.RecordWriter = (*Writer)(nil)
+
+// NewWriter returns a Writer sending records to sink, under the given topic prefix or
+// DefaultTopicPrefix if empty.
+func NewWriter(sink Sink, topicPrefix string) *Writer {
+	if topicPrefix == "" {
+		topicPrefix = DefaultTopicPrefix
+	}
+	return &Writer{sink: sink, topicPrefix: topicPrefix}
+}
+
+// NewListener returns an ABCIListener sending records to sink, under the given topic prefix.
+func NewListener(sink Sink, topicPrefix string) *streamingabci.RecordListener {
+	return streamingabci.NewRecordListener(NewWriter(sink, topicPrefix))
+}
+
+// Topic returns the topic records of the given type are published to.
+func (w *Writer) Topic(t streamingabci.RecordType) string {
+	return w.topicPrefix + "." + t.String()
+}
+
+// WriteRecord implements streamingabci.RecordWriter interface
+func (w *Writer) WriteRecord(height int64, t streamingabci.RecordType, msg proto.Message) error {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height))
+
+	return w.sink.Send(context.Background(), w.Topic(t), key, value)
+}
+
+// Commit implements streamingabci.RecordWriter interface
+func (w *Writer) Commit(_ int64) error {
+	return w.sink.Flush(context.Background())
+}
+
+// Close closes the sink.
+func (w *Writer) Close() error {
+	return w.sink.Close()
+}
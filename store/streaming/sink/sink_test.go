@@ -0,0 +1,99 @@
+package sink
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type message struct {
+	topic string
+	key   []byte
+	value []byte
+}
+
+// memorySink delivers the messages sent to it on Flush.
+type memorySink struct {
+	pending   []message
+	delivered []message
+	closed    bool
+}
+
+func (s *memorySink) Send(_ context.Context, topic string, key, value []byte) error {
+	s.pending = append(s.pending, message{topic: topic, key: key, value: value})
+	return nil
+}
+
+func (s *memorySink) Flush(context.Context) error {
+	s.delivered = append(s.delivered, s.pending...)
+	s.pending = nil
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.closed = true
+	return s.Flush(context.Background())
+}
+
+func TestListener(t *testing.T) {
+	sink := &memorySink{}
+	listener := NewListener(sink, "").WithStoreKeys("bank")
+	ctx := context.Background()
+
+	require.NoError(t, listener.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 7}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, listener.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 7}, abci.ResponseEndBlock{}))
+	require.Empty(t, sink.delivered)
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "acc", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "bank", Key: []byte("b"), Value: []byte("2")},
+	}
+	require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+	require.Empty(t, sink.pending)
+
+	var topics []string
+	for _, msg := range sink.delivered {
+		topics = append(topics, msg.topic)
+		require.Equal(t, uint64(7), binary.BigEndian.Uint64(msg.key))
+	}
+	require.Equal(t, []string{"cosmos.begin_block", "cosmos.end_block", "cosmos.store_kv_pair", "cosmos.commit"}, topics)
+
+	var pair storetypes.StoreKVPair
+	require.NoError(t, pair.Unmarshal(sink.delivered[2].value))
+	require.Equal(t, *changeSet[1], pair)
+
+	var commit streamingabci.ListenCommitRequest
+	require.NoError(t, commit.Unmarshal(sink.delivered[3].value))
+	require.Equal(t, int64(7), commit.BlockHeight)
+}
+
+func TestRegister(t *testing.T) {
+	Register("test-memory", func(options map[string]string) (Sink, error) {
+		if options["brokers"] == "" {
+			return nil, errors.New("brokers must be set")
+		}
+		return &memorySink{}, nil
+	})
+	require.Panics(t, func() {
+		Register("test-memory", func(map[string]string) (Sink, error) { return nil, nil })
+	})
+	require.Contains(t, Registered(), "test-memory")
+
+	s, err := New("test-memory", map[string]string{"brokers": "localhost:9092"})
+	require.NoError(t, err)
+	require.IsType(t, &memorySink{}, s)
+
+	_, err = New("test-memory", nil)
+	require.ErrorContains(t, err, "brokers must be set")
+
+	_, err = New("unknown", nil)
+	require.ErrorContains(t, err, "unknown streaming sink")
+}
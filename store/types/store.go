@@ -191,6 +191,10 @@ type CommitMultiStore interface {
 	// SetIAVLLazyLoading enable/disable lazy loading on iavl.
 	SetLazyLoading(lazyLoading bool)
 
+	// SetBackgroundPruning enables/disables the deletion of the pruned versions in the
+	// background, deleting at most rateLimit store versions per second if not zero.
+	SetBackgroundPruning(enable bool, rateLimit uint64)
+
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
 
@@ -21,6 +21,16 @@ type ABCIListener interface {
 	ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*StoreKVPair) error
 }
 
+// ResumableABCIListener is an ABCIListener persisting the height of the last block it delivered,
+// so that the change sets of the blocks it missed, after an error or a restart, are replayed to it
+// with ListenCommit before the next block.
+type ResumableABCIListener interface {
+	ABCIListener
+	// LastDeliveredHeight returns the height of the last block whose change set was delivered
+	// and acknowledged by the listener, or 0 if it didn't deliver any block yet.
+	LastDeliveredHeight() int64
+}
+
 // StreamingManager is the struct that maintains a list of ABCIListeners and configuration settings.
 type StreamingManager struct {
 	// ABCIListeners for hooking into the ABCI message processing of the BaseApp
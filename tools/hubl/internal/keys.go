@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagRecover      string = "recover"
+	flagBech32Prefix string = "bech32-prefix"
+)
+
+// keyringAppName is the service name under which the keys are stored by the keyring backends.
+const keyringAppName = "hubl"
+
+// OpenKeyring opens the hubl keyring, which is shared by all the chains. Its keys are stored in the config directory
+// for the file based backends.
+func OpenKeyring(configDir, backend string, userInput io.Reader) (keyring.Keyring, error) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	return keyring.New(keyringAppName, backend, configDir, userInput, codec.NewProtoCodec(registry))
+}
+
+func KeysCommand(configDir string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the keys used to sign transactions",
+		Long: `Manage the keys used to sign transactions on any chain.
+The keys aren't bound to a chain, their addresses are encoded with the bech32 prefix of the chain they are used on.`,
+	}
+
+	cmd.AddCommand(
+		keysAddCommand(configDir),
+		keysListCommand(configDir),
+		keysImportCommand(configDir),
+	)
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "select the keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.PersistentFlags().String(flagBech32Prefix, sdk.Bech32MainPrefix, "the bech32 prefix used to display the addresses of the keys")
+
+	return cmd
+}
+
+func keysAddCommand(configDir string) *cobra.Command {
+	var recover bool
+
+	cmd := &cobra.Command{
+		Use:   "add [name]",
+		Short: "Add a new key, or recover one from its mnemonic with the --recover flag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := openKeyringFromFlags(cmd, configDir)
+			if err != nil {
+				return err
+			}
+
+			var (
+				record   *keyring.Record
+				mnemonic string
+			)
+			if recover {
+				mnemonic, err = input.GetString("Enter your bip39 mnemonic", bufio.NewReader(cmd.InOrStdin()))
+				if err != nil {
+					return err
+				}
+
+				record, err = kr.NewAccount(args[0], mnemonic, keyring.DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256k1)
+			} else {
+				record, mnemonic, err = kr.NewMnemonic(args[0], keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+			}
+			if err != nil {
+				return err
+			}
+
+			if err := printKey(cmd, record); err != nil {
+				return err
+			}
+
+			if !recover {
+				cmd.Printf("\n**Important** write this mnemonic phrase in a safe place.\nIt is the only way to recover your key if you ever forget your password.\n\n%s\n", mnemonic)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&recover, flagRecover, false, "provide the mnemonic of the key to recover")
+
+	return cmd
+}
+
+func keysListCommand(configDir string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List all the keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := openKeyringFromFlags(cmd, configDir)
+			if err != nil {
+				return err
+			}
+
+			records, err := kr.List()
+			if err != nil {
+				return err
+			}
+
+			for _, record := range records {
+				if err := printKey(cmd, record); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func keysImportCommand(configDir string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [name] [keyfile]",
+		Short: "Import a private key exported with the keys export command of a chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kr, err := openKeyringFromFlags(cmd, configDir)
+			if err != nil {
+				return err
+			}
+
+			armor, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", bufio.NewReader(cmd.InOrStdin()))
+			if err != nil {
+				return err
+			}
+
+			if err := kr.ImportPrivKey(args[0], string(armor), passphrase); err != nil {
+				return err
+			}
+
+			record, err := kr.Key(args[0])
+			if err != nil {
+				return err
+			}
+
+			return printKey(cmd, record)
+		},
+	}
+}
+
+func openKeyringFromFlags(cmd *cobra.Command, configDir string) (keyring.Keyring, error) {
+	backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	if err != nil {
+		return nil, err
+	}
+
+	return OpenKeyring(configDir, backend, cmd.InOrStdin())
+}
+
+func printKey(cmd *cobra.Command, record *keyring.Record) error {
+	prefix, err := cmd.Flags().GetString(flagBech32Prefix)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	address, err := bech32.ConvertAndEncode(prefix, pubKey.Address())
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("- name: %s\n  address: %s\n  pubkey: %X\n", record.Name, address, pubKey.Bytes())
+	return nil
+}
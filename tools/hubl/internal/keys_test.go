@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"gotest.tools/v3/assert"
+)
+
+const testMnemonic = "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+// execKeysCommand runs the keys command with the test keyring backend and the test bech32 prefix.
+func execKeysCommand(t *testing.T, configDir, stdin string, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := KeysCommand(configDir)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetArgs(append(args, "--"+flags.FlagKeyringBackend, keyring.BackendTest, "--"+flagBech32Prefix, testPrefix))
+
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+// testKeyAddress returns the address of the key derived from the mnemonic with the test bech32 prefix.
+func testKeyAddress(t *testing.T, mnemonic string) string {
+	t.Helper()
+
+	kr, err := OpenKeyring(t.TempDir(), keyring.BackendMemory, nil)
+	assert.NilError(t, err)
+	record, err := kr.NewAccount("key", mnemonic, keyring.DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256k1)
+	assert.NilError(t, err)
+	pubKey, err := record.GetPubKey()
+	assert.NilError(t, err)
+	address, err := bech32.ConvertAndEncode(testPrefix, pubKey.Address())
+	assert.NilError(t, err)
+
+	return address
+}
+
+func TestKeysAdd(t *testing.T) {
+	configDir := t.TempDir()
+
+	// a new key prints its mnemonic
+	out, err := execKeysCommand(t, configDir, "", "add", "alice")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "- name: alice\n  address: "+testPrefix+"1"), out)
+	mnemonic := strings.TrimSpace(out[strings.LastIndex(out, "\n\n"):])
+	assert.Equal(t, len(strings.Fields(mnemonic)), 24)
+	assert.Assert(t, strings.Contains(out, testKeyAddress(t, mnemonic)), out)
+
+	// a recovered key doesn't
+	out, err = execKeysCommand(t, configDir, testMnemonic+"\n", "add", "bob", "--"+flagRecover)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "- name: bob\n  address: "+testKeyAddress(t, testMnemonic)), out)
+	assert.Assert(t, !strings.Contains(out, "mnemonic phrase"), out)
+
+	// the keys are saved in the config directory
+	kr, err := OpenKeyring(configDir, keyring.BackendTest, nil)
+	assert.NilError(t, err)
+	_, err = kr.Key("alice")
+	assert.NilError(t, err)
+	_, err = kr.Key("bob")
+	assert.NilError(t, err)
+
+	_, err = execKeysCommand(t, configDir, "not a mnemonic\n", "add", "carol", "--"+flagRecover)
+	assert.ErrorContains(t, err, "Invalid mnemonic")
+}
+
+func TestKeysList(t *testing.T) {
+	configDir := t.TempDir()
+
+	out, err := execKeysCommand(t, configDir, "", "list")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "")
+
+	_, err = execKeysCommand(t, configDir, testMnemonic+"\n", "add", "bob", "--"+flagRecover)
+	assert.NilError(t, err)
+	_, err = execKeysCommand(t, configDir, "", "add", "alice")
+	assert.NilError(t, err)
+
+	out, err = execKeysCommand(t, configDir, "", "list")
+	assert.NilError(t, err)
+	assert.Equal(t, strings.Count(out, "- name: "), 2)
+	assert.Assert(t, strings.Index(out, "- name: alice") < strings.Index(out, "- name: bob"), out)
+	assert.Assert(t, strings.Contains(out, "- name: bob\n  address: "+testKeyAddress(t, testMnemonic)), out)
+}
+
+func TestKeysImport(t *testing.T) {
+	configDir := t.TempDir()
+	const passphrase = "12345678"
+
+	// export a key from another keyring, as done by the keys export command of a chain
+	kr, err := OpenKeyring(t.TempDir(), keyring.BackendMemory, nil)
+	assert.NilError(t, err)
+	_, err = kr.NewAccount("exported", testMnemonic, keyring.DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256k1)
+	assert.NilError(t, err)
+	armor, err := kr.ExportPrivKeyArmor("exported", passphrase)
+	assert.NilError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.armor")
+	assert.NilError(t, os.WriteFile(keyFile, []byte(armor), 0o600))
+
+	_, err = execKeysCommand(t, configDir, "wrong passphrase\n", "import", "alice", keyFile)
+	assert.ErrorContains(t, err, "invalid account password")
+
+	out, err := execKeysCommand(t, configDir, passphrase+"\n", "import", "alice", keyFile)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "- name: alice\n  address: "+testKeyAddress(t, testMnemonic)), out)
+
+	_, err = execKeysCommand(t, configDir, passphrase+"\n", "import", "alice", keyFile)
+	assert.ErrorContains(t, err, "cannot overwrite key")
+
+	_, err = execKeysCommand(t, configDir, passphrase+"\n", "import", "bob", filepath.Join(t.TempDir(), "missing"))
+	assert.Assert(t, os.IsNotExist(err), err)
+}
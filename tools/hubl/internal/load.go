@@ -2,28 +2,50 @@ package internal
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
+	"time"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/go-multierror"
+	"github.com/pelletier/go-toml/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-const DefaultConfigDirName = ".hubl"
+const (
+	DefaultConfigDirName = ".hubl"
+
+	// healthCheckTimeout is the time an endpoint has to answer before failing over to the next one.
+	healthCheckTimeout = 5 * time.Second
+
+	// unknownVersion is the version under which the descriptors of the chains not exposing their version are cached.
+	unknownVersion = "unknown"
+)
 
 type ChainInfo struct {
 	client *grpc.ClientConn
+	// nodeVersion is the application version of the node the client is connected to.
+	nodeVersion string
+	// cachedVersion is the chain version of the loaded descriptors.
+	cachedVersion string
+	// cachedHash is the hex encoded sha256 hash of the loaded file descriptor set.
+	cachedHash string
 
 	ConfigDir     string
 	Chain         string
@@ -42,68 +64,134 @@ func NewChainInfo(configDir string, chain string, config *ChainConfig) *ChainInf
 	}
 }
 
+// chainCache is the index of the cached descriptors of a chain. The descriptors are cached per version of the
+// chain, so that the commands can be built offline and switching between nodes of different versions doesn't
+// require fetching them again.
+type chainCache struct {
+	// Current is the chain version the commands are built for.
+	Current string `toml:"current"`
+	// Hashes are the hex encoded sha256 hashes of the cached file descriptor sets by chain version.
+	Hashes map[string]string `toml:"hashes"`
+}
+
 func (c *ChainInfo) getCacheDir() (string, error) {
-	cacheDir := path.Join(c.ConfigDir, "cache")
+	cacheDir := path.Join(c.ConfigDir, "cache", c.Chain)
 	return cacheDir, os.MkdirAll(cacheDir, 0o755)
 }
 
-func (c *ChainInfo) fdsCacheFilename() (string, error) {
+func (c *ChainInfo) cacheIndexFilename() (string, error) {
 	cacheDir, err := c.getCacheDir()
 	if err != nil {
 		return "", err
 	}
-	return path.Join(cacheDir, fmt.Sprintf("%s.fds", c.Chain)), nil
+	return path.Join(cacheDir, "cache.toml"), nil
 }
 
-func (c *ChainInfo) appOptsCacheFilename() (string, error) {
+func (c *ChainInfo) fdsCacheFilename(version string) (string, error) {
 	cacheDir, err := c.getCacheDir()
 	if err != nil {
 		return "", err
 	}
-	return path.Join(cacheDir, fmt.Sprintf("%s.autocli", c.Chain)), nil
+	return path.Join(cacheDir, fmt.Sprintf("%s.fds", url.PathEscape(version))), nil
 }
 
+func (c *ChainInfo) appOptsCacheFilename(version string) (string, error) {
+	cacheDir, err := c.getCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(cacheDir, fmt.Sprintf("%s.autocli", url.PathEscape(version))), nil
+}
+
+func (c *ChainInfo) loadCacheIndex() (*chainCache, error) {
+	index := &chainCache{Hashes: map[string]string{}}
+	filename, err := c.cacheIndexFilename()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return index, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = toml.Unmarshal(bz, index); err != nil {
+		return nil, errors.Wrapf(err, "can't load cache index: %s", filename)
+	}
+	if index.Hashes == nil {
+		index.Hashes = map[string]string{}
+	}
+
+	return index, nil
+}
+
+func (c *ChainInfo) saveCacheIndex(index *chainCache) error {
+	filename, err := c.cacheIndexFilename()
+	if err != nil {
+		return err
+	}
+
+	bz, err := toml.Marshal(index)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, bz, 0o644)
+}
+
+// Load loads the file descriptors and autocli options of the chain. They are read from the cache of the current
+// chain version if there is one, which doesn't require a connection to the chain, and fetched from the chain
+// otherwise or if reload is set. Fetched descriptors are only written to the cache if their hash changed. Cached
+// descriptors are compared with the ones of the node when a client is opened, and a warning is printed if they
+// changed.
 func (c *ChainInfo) Load(reload bool) error {
-	fdSet := &descriptorpb.FileDescriptorSet{}
-	fdsFilename, err := c.fdsCacheFilename()
+	index, err := c.loadCacheIndex()
 	if err != nil {
 		return err
 	}
 
-	if _, err := os.Stat(fdsFilename); os.IsNotExist(err) || reload {
-		client, err := c.OpenClient()
-		if err != nil {
-			return err
+	if !reload && index.Current != "" {
+		if err := c.loadCache(index.Current); err == nil {
+			c.cachedHash = index.Hashes[index.Current]
+			return nil
 		}
+	}
 
-		reflectionClient := reflectionv1.NewReflectionServiceClient(client)
-		fdRes, err := reflectionClient.FileDescriptors(c.Context, &reflectionv1.FileDescriptorsRequest{})
-		if err != nil {
-			fdSet, err = loadFileDescriptorsGRPCReflection(c.Context, client)
-			if err != nil {
-				return err
-			}
-		} else {
-			fdSet = &descriptorpb.FileDescriptorSet{File: fdRes.Files}
-		}
+	return c.fetch(index)
+}
 
-		bz, err := proto.Marshal(fdSet)
-		if err != nil {
-			return err
-		}
+// loadCache loads the cached file descriptors and autocli options of a chain version.
+func (c *ChainInfo) loadCache(version string) error {
+	fdsFilename, err := c.fdsCacheFilename(version)
+	if err != nil {
+		return err
+	}
 
-		if err = os.WriteFile(fdsFilename, bz, 0o644); err != nil {
-			return err
-		}
-	} else {
-		bz, err := os.ReadFile(fdsFilename)
-		if err != nil {
-			return err
-		}
+	bz, err := os.ReadFile(fdsFilename)
+	if err != nil {
+		return err
+	}
 
-		if err = proto.Unmarshal(bz, fdSet); err != nil {
-			return err
-		}
+	fdSet := &descriptorpb.FileDescriptorSet{}
+	if err = proto.Unmarshal(bz, fdSet); err != nil {
+		return err
+	}
+
+	appOptsFilename, err := c.appOptsCacheFilename(version)
+	if err != nil {
+		return err
+	}
+
+	bz, err = os.ReadFile(appOptsFilename)
+	if err != nil {
+		return err
+	}
+
+	var appOptsRes autocliv1.AppOptionsResponse
+	if err = proto.Unmarshal(bz, &appOptsRes); err != nil {
+		return err
 	}
 
 	c.ProtoFiles, err = protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(fdSet)
@@ -111,50 +199,81 @@ func (c *ChainInfo) Load(reload bool) error {
 		return fmt.Errorf("error building protoregistry.Files: %w", err)
 	}
 
-	appOptsFilename, err := c.appOptsCacheFilename()
+	c.ModuleOptions = appOptsRes.ModuleOptions
+	c.cachedVersion = version
+	return nil
+}
+
+// fetch fetches the file descriptors and autocli options from the chain and caches them for its version.
+func (c *ChainInfo) fetch(index *chainCache) error {
+	// the descriptors being replaced aren't checked against the ones of the node
+	c.cachedVersion, c.cachedHash = "", ""
+
+	client, err := c.OpenClient()
 	if err != nil {
 		return err
 	}
 
-	if _, err := os.Stat(appOptsFilename); os.IsNotExist(err) || reload {
-		client, err := c.OpenClient()
-		if err != nil {
-			return err
-		}
+	fdSet, bz, hexHash, err := c.fetchFileDescriptors(client)
+	if err != nil {
+		return err
+	}
 
-		autocliQueryClient := autocliv1.NewQueryClient(client)
-		appOptionsRes, err := autocliQueryClient.AppOptions(c.Context, &autocliv1.AppOptionsRequest{})
-		if err != nil {
-			appOptionsRes = guessAutocli(c.ProtoFiles)
-		}
+	version := c.nodeVersion
+	if version == "" {
+		version = unknownVersion
+	}
 
-		bz, err := proto.Marshal(appOptionsRes)
-		if err != nil {
-			return err
+	if cachedHash, ok := index.Hashes[version]; ok {
+		if cachedHash == hexHash && c.loadCache(version) == nil {
+			index.Current = version
+			return c.saveCacheIndex(index)
 		}
 
-		err = os.WriteFile(appOptsFilename, bz, 0o644)
-		if err != nil {
-			return err
-		}
+		fmt.Fprintf(os.Stderr, "The descriptors of %s %s changed, updating the cache\n", c.Chain, version)
+	}
 
-		c.ModuleOptions = appOptionsRes.ModuleOptions
-	} else {
-		bz, err := os.ReadFile(appOptsFilename)
-		if err != nil {
-			return err
-		}
+	fdsFilename, err := c.fdsCacheFilename(version)
+	if err != nil {
+		return err
+	}
 
-		var appOptsRes autocliv1.AppOptionsResponse
-		err = proto.Unmarshal(bz, &appOptsRes)
-		if err != nil {
-			return err
-		}
+	if err = os.WriteFile(fdsFilename, bz, 0o644); err != nil {
+		return err
+	}
 
-		c.ModuleOptions = appOptsRes.ModuleOptions
+	c.ProtoFiles, err = protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(fdSet)
+	if err != nil {
+		return fmt.Errorf("error building protoregistry.Files: %w", err)
 	}
 
-	return nil
+	autocliQueryClient := autocliv1.NewQueryClient(client)
+	appOptionsRes, err := autocliQueryClient.AppOptions(c.Context, &autocliv1.AppOptionsRequest{})
+	if err != nil {
+		appOptionsRes = guessAutocli(c.ProtoFiles)
+	}
+
+	bz, err = proto.Marshal(appOptionsRes)
+	if err != nil {
+		return err
+	}
+
+	appOptsFilename, err := c.appOptsCacheFilename(version)
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(appOptsFilename, bz, 0o644); err != nil {
+		return err
+	}
+
+	c.ModuleOptions = appOptionsRes.ModuleOptions
+	c.cachedVersion = version
+	c.cachedHash = hexHash
+
+	index.Current = version
+	index.Hashes[version] = hexHash
+	return c.saveCacheIndex(index)
 }
 
 func (c *ChainInfo) OpenClient() (*grpc.ClientConn, error) {
@@ -162,6 +281,10 @@ func (c *ChainInfo) OpenClient() (*grpc.ClientConn, error) {
 		return c.client, nil
 	}
 
+	if len(c.Config.GRPCEndpoints) == 0 {
+		return nil, fmt.Errorf("error loading gRPC client: no gRPC endpoint configured for %s", c.Chain)
+	}
+
 	var res error
 	for _, endpoint := range c.Config.GRPCEndpoints {
 		var creds credentials.TransportCredentials
@@ -173,15 +296,97 @@ func (c *ChainInfo) OpenClient() (*grpc.ClientConn, error) {
 			})
 		}
 
-		var err error
-		c.client, err = grpc.Dial(endpoint.Endpoint, grpc.WithTransportCredentials(creds))
+		client, err := grpc.Dial(endpoint.Endpoint, grpc.WithTransportCredentials(creds))
 		if err != nil {
 			res = multierror.Append(res, err)
 			continue
 		}
 
+		// the connection is lazy, so the endpoint is queried to fail over to the next one if it's unreachable
+		version, err := c.healthCheck(client)
+		if err != nil {
+			_ = client.Close()
+			res = multierror.Append(res, errors.Wrapf(err, "endpoint %s is unhealthy", endpoint.Endpoint))
+			continue
+		}
+
+		if c.cachedVersion != "" && version != "" && version != c.cachedVersion {
+			fmt.Fprintf(os.Stderr, "%s is running version %s but the commands were built for version %s, run `hubl %s --update` to update them\n", c.Chain, version, c.cachedVersion, c.Chain)
+		} else if changed, err := c.descriptorsChanged(client); err == nil && changed {
+			fmt.Fprintf(os.Stderr, "The descriptors of %s changed since the commands were built, run `hubl %s --update` to update them\n", c.Chain, c.Chain)
+		}
+
+		c.client = client
+		c.nodeVersion = version
 		return c.client, nil
 	}
 
 	return nil, errors.Wrapf(res, "error loading gRPC client")
 }
+
+// fetchFileDescriptors fetches the file descriptor set of the chain, and returns it with its deterministic encoding
+// and the hex encoded sha256 hash of the encoding.
+func (c *ChainInfo) fetchFileDescriptors(client *grpc.ClientConn) (*descriptorpb.FileDescriptorSet, []byte, string, error) {
+	var fdSet *descriptorpb.FileDescriptorSet
+	reflectionClient := reflectionv1.NewReflectionServiceClient(client)
+	fdRes, err := reflectionClient.FileDescriptors(c.Context, &reflectionv1.FileDescriptorsRequest{})
+	if err != nil {
+		fdSet, err = loadFileDescriptorsGRPCReflection(c.Context, client)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	} else {
+		fdSet = &descriptorpb.FileDescriptorSet{File: fdRes.Files}
+	}
+
+	bz, hexHash, err := hashFileDescriptors(fdSet)
+	return fdSet, bz, hexHash, err
+}
+
+// descriptorsChanged compares the hash of the file descriptors of the node with the one of the cached descriptors
+// the commands were built from. It returns false if the descriptors weren't loaded from the cache. Only the nodes
+// exposing cosmos.reflection.v1 are checked, the gRPC reflection fallback being too slow to run for every command.
+func (c *ChainInfo) descriptorsChanged(client *grpc.ClientConn) (bool, error) {
+	if c.cachedHash == "" {
+		return false, nil
+	}
+
+	fdRes, err := reflectionv1.NewReflectionServiceClient(client).FileDescriptors(c.Context, &reflectionv1.FileDescriptorsRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	_, hexHash, err := hashFileDescriptors(&descriptorpb.FileDescriptorSet{File: fdRes.Files})
+	if err != nil {
+		return false, err
+	}
+
+	return hexHash != c.cachedHash, nil
+}
+
+// hashFileDescriptors returns the deterministic encoding of a file descriptor set and its hex encoded sha256 hash.
+func hashFileDescriptors(fdSet *descriptorpb.FileDescriptorSet) ([]byte, string, error) {
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(fdSet)
+	if err != nil {
+		return nil, "", err
+	}
+
+	hash := sha256.Sum256(bz)
+	return bz, hex.EncodeToString(hash[:]), nil
+}
+
+// healthCheck checks that the node is reachable and returns its application version. Nodes without the
+// tendermint service are considered healthy, with an unknown version.
+func (c *ChainInfo) healthCheck(client *grpc.ClientConn) (string, error) {
+	ctx, cancel := context.WithTimeout(c.Context, healthCheckTimeout)
+	defer cancel()
+
+	res, err := cmtv1beta1.NewServiceClient(client).GetNodeInfo(ctx, &cmtv1beta1.GetNodeInfoRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return res.GetApplicationVersion().GetVersion(), nil
+}
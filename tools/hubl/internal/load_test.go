@@ -0,0 +1,217 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	cmtv1beta1 "cosmossdk.io/api/cosmos/base/tendermint/v1beta1"
+	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gotest.tools/v3/assert"
+)
+
+// testNode is a fake node serving its file descriptors and application version.
+type testNode struct {
+	reflectionv1.UnimplementedReflectionServiceServer
+	cmtv1beta1.UnimplementedServiceServer
+
+	version string
+	files   []*descriptorpb.FileDescriptorProto
+	err     error
+}
+
+func (n *testNode) FileDescriptors(context.Context, *reflectionv1.FileDescriptorsRequest) (*reflectionv1.FileDescriptorsResponse, error) {
+	return &reflectionv1.FileDescriptorsResponse{Files: n.files}, nil
+}
+
+func (n *testNode) GetNodeInfo(context.Context, *cmtv1beta1.GetNodeInfoRequest) (*cmtv1beta1.GetNodeInfoResponse, error) {
+	if n.err != nil {
+		return nil, n.err
+	}
+	return &cmtv1beta1.GetNodeInfoResponse{ApplicationVersion: &cmtv1beta1.VersionInfo{Version: n.version}}, nil
+}
+
+func startTestNode(t *testing.T, node *testNode) string {
+	t.Helper()
+
+	return startTestServer(t, func(server *grpc.Server) {
+		reflectionv1.RegisterReflectionServiceServer(server, node)
+		cmtv1beta1.RegisterServiceServer(server, node)
+	})
+}
+
+// unreachableEndpoint returns the address of a closed local port.
+func unreachableEndpoint(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	assert.NilError(t, listener.Close())
+	return listener.Addr().String()
+}
+
+var (
+	queryFiles   = []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(bankv1beta1.File_cosmos_bank_v1beta1_query_proto)}
+	upgradeFiles = []*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(bankv1beta1.File_cosmos_bank_v1beta1_query_proto),
+		protodesc.ToFileDescriptorProto(bankv1beta1.File_cosmos_bank_v1beta1_tx_proto),
+	}
+)
+
+func TestCacheIndex(t *testing.T) {
+	chainInfo := NewChainInfo(t.TempDir(), "test", &ChainConfig{})
+
+	index, err := chainInfo.loadCacheIndex()
+	assert.NilError(t, err)
+	assert.Equal(t, index.Current, "")
+	assert.Assert(t, index.Hashes != nil)
+
+	index = &chainCache{Current: "v1", Hashes: map[string]string{"v1": "aa", "v1/rc": "bb"}}
+	assert.NilError(t, chainInfo.saveCacheIndex(index))
+	loaded, err := chainInfo.loadCacheIndex()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, loaded, index)
+
+	// the versions are escaped in the names of the cache files
+	filename, err := chainInfo.fdsCacheFilename("v1/rc")
+	assert.NilError(t, err)
+	assert.Equal(t, filepath.Base(filename), "v1%2Frc.fds")
+	filename, err = chainInfo.appOptsCacheFilename("v1/rc")
+	assert.NilError(t, err)
+	assert.Equal(t, filepath.Base(filename), "v1%2Frc.autocli")
+
+	filename, err = chainInfo.cacheIndexFilename()
+	assert.NilError(t, err)
+	assert.NilError(t, os.WriteFile(filename, []byte("current = "), 0o644))
+	_, err = chainInfo.loadCacheIndex()
+	assert.ErrorContains(t, err, "can't load cache index")
+}
+
+func TestLoad(t *testing.T) {
+	configDir := t.TempDir()
+	openChainInfo := func(endpoints ...string) *ChainInfo {
+		chainInfo := newTestChainInfo(t, endpoints...)
+		chainInfo.ConfigDir = configDir
+		return chainInfo
+	}
+	loadIndex := func() *chainCache {
+		index, err := openChainInfo().loadCacheIndex()
+		assert.NilError(t, err)
+		return index
+	}
+
+	// the descriptors are fetched and cached for the version of the node
+	v1Node := startTestNode(t, &testNode{version: "v1", files: queryFiles})
+	chainInfo := openChainInfo(v1Node)
+	assert.NilError(t, chainInfo.Load(false))
+	assert.Equal(t, chainInfo.cachedVersion, "v1")
+	_, err := chainInfo.ProtoFiles.FindDescriptorByName("cosmos.bank.v1beta1.Query")
+	assert.NilError(t, err)
+	assert.Assert(t, chainInfo.ModuleOptions["bank"] != nil)
+	index := loadIndex()
+	assert.Equal(t, index.Current, "v1")
+	v1Hash := index.Hashes["v1"]
+	assert.Equal(t, chainInfo.cachedHash, v1Hash)
+
+	// they're loaded from the cache without connecting to the chain
+	chainInfo = openChainInfo()
+	assert.NilError(t, chainInfo.Load(false))
+	assert.Equal(t, chainInfo.cachedVersion, "v1")
+	assert.Equal(t, chainInfo.cachedHash, v1Hash)
+	_, err = chainInfo.ProtoFiles.FindDescriptorByName("cosmos.bank.v1beta1.Query")
+	assert.NilError(t, err)
+	assert.Assert(t, chainInfo.ModuleOptions["bank"] != nil)
+	assert.ErrorContains(t, chainInfo.Load(true), "no gRPC endpoint configured for test")
+
+	// the descriptors of another version are cached along the ones of the previous versions
+	v2Node := startTestNode(t, &testNode{version: "v2", files: upgradeFiles})
+	chainInfo = openChainInfo(v2Node)
+	assert.NilError(t, chainInfo.Load(true))
+	assert.Equal(t, chainInfo.cachedVersion, "v2")
+	_, err = chainInfo.ProtoFiles.FindDescriptorByName("cosmos.bank.v1beta1.Msg")
+	assert.NilError(t, err)
+	index = loadIndex()
+	assert.Equal(t, index.Current, "v2")
+	assert.Equal(t, index.Hashes["v1"], v1Hash)
+	assert.Assert(t, index.Hashes["v2"] != v1Hash)
+
+	// switching back to a cached version loads its descriptors
+	chainInfo = openChainInfo(v1Node)
+	assert.NilError(t, chainInfo.Load(true))
+	assert.Equal(t, chainInfo.cachedVersion, "v1")
+	_, err = chainInfo.ProtoFiles.FindDescriptorByName("cosmos.bank.v1beta1.Msg")
+	assert.ErrorContains(t, err, "not found")
+	assert.Equal(t, loadIndex().Current, "v1")
+
+	// the cached descriptors are compared with the ones of the node when a client is opened
+	changedNode := startTestNode(t, &testNode{version: "v1", files: upgradeFiles})
+	chainInfo = openChainInfo(changedNode)
+	assert.NilError(t, chainInfo.Load(false))
+	assert.Equal(t, chainInfo.cachedHash, v1Hash)
+	client, err := chainInfo.OpenClient()
+	assert.NilError(t, err)
+	changed, err := chainInfo.descriptorsChanged(client)
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+
+	// and updated in the cache of the version when they're fetched again
+	assert.NilError(t, chainInfo.Load(true))
+	assert.Equal(t, chainInfo.cachedVersion, "v1")
+	assert.Assert(t, chainInfo.cachedHash != v1Hash)
+	assert.Equal(t, loadIndex().Hashes["v1"], chainInfo.cachedHash)
+	changed, err = chainInfo.descriptorsChanged(client)
+	assert.NilError(t, err)
+	assert.Assert(t, !changed)
+
+	// the descriptors fetched from the chain aren't checked
+	chainInfo = openChainInfo(v2Node)
+	chainInfo.cachedHash = ""
+	client, err = chainInfo.OpenClient()
+	assert.NilError(t, err)
+	changed, err = chainInfo.descriptorsChanged(client)
+	assert.NilError(t, err)
+	assert.Assert(t, !changed)
+}
+
+func TestOpenClientFailover(t *testing.T) {
+	unreachable := unreachableEndpoint(t)
+	unhealthy := startTestNode(t, &testNode{err: status.Error(codes.Internal, "node is syncing")})
+	healthy := startTestNode(t, &testNode{version: "v1"})
+	// nodes without the tendermint service are healthy with an unknown version
+	unversioned := startTestServer(t, func(server *grpc.Server) {
+		reflectionv1.RegisterReflectionServiceServer(server, &testNode{})
+	})
+
+	chainInfo := newTestChainInfo(t, unreachable, unhealthy, healthy)
+	client, err := chainInfo.OpenClient()
+	assert.NilError(t, err)
+	assert.Equal(t, client.Target(), healthy)
+	assert.Equal(t, chainInfo.nodeVersion, "v1")
+
+	// the client is reused
+	reused, err := chainInfo.OpenClient()
+	assert.NilError(t, err)
+	assert.Equal(t, reused, client)
+
+	chainInfo = newTestChainInfo(t, unversioned, healthy)
+	client, err = chainInfo.OpenClient()
+	assert.NilError(t, err)
+	assert.Equal(t, client.Target(), unversioned)
+	assert.Equal(t, chainInfo.nodeVersion, "")
+
+	chainInfo = newTestChainInfo(t, unreachable, unhealthy)
+	_, err = chainInfo.OpenClient()
+	assert.ErrorContains(t, err, "error loading gRPC client")
+	assert.Assert(t, strings.Contains(err.Error(), "endpoint "+unreachable+" is unhealthy"), err)
+	assert.Assert(t, strings.Contains(err.Error(), "endpoint "+unhealthy+" is unhealthy"), err)
+	assert.Assert(t, strings.Contains(err.Error(), "node is syncing"), err)
+}
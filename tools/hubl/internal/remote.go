@@ -17,9 +17,10 @@ import (
 )
 
 var (
-	flagInsecure string = "insecure"
-	flagUpdate   string = "update"
-	flagConfig   string = "config"
+	flagInsecure      string = "insecure"
+	flagUpdate        string = "update"
+	flagConfig        string = "config"
+	flagGRPCEndpoints string = "grpc-endpoints"
 )
 
 func RootCommand() (*cobra.Command, error) {
@@ -45,7 +46,7 @@ func RootCommand() (*cobra.Command, error) {
 	if err != nil {
 		return nil, err
 	}
-	commands = append(commands, InitCommand(config, configDir))
+	commands = append(commands, InitCommand(config, configDir), KeysCommand(configDir))
 
 	cmd.AddCommand(commands...)
 	return cmd, nil
@@ -58,7 +59,8 @@ func InitCommand(config *Config, configDir string) *cobra.Command {
 		Use:   "init [foochain]",
 		Short: "Initialize a new chain",
 		Long: `To configure a new chain just run this command using the --init flag and the name of the chain as it's listed in the chain registry (https://github.com/cosmos/chain-registry).
-If the chain is not listed in the chain registry, you can use any unique name.`,
+If the chain is not listed in the chain registry, you can use any unique name.
+Several gRPC endpoints can be provided with the --grpc-endpoints flag, they are tried in order until a healthy one is found.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			chainName := strings.ToLower(args[0])
@@ -68,6 +70,7 @@ If the chain is not listed in the chain registry, you can use any unique name.`,
 	}
 
 	cmd.Flags().BoolVar(&insecure, flagInsecure, false, "allow setting up insecure gRPC connection")
+	cmd.Flags().StringSlice(flagGRPCEndpoints, nil, "trusted gRPC endpoints of the chain, in order of preference, instead of selecting one from the chain registry")
 
 	return cmd
 }
@@ -98,6 +101,8 @@ func RemoteCommand(config *Config, configDir string) ([]*cobra.Command, error) {
 				return chainInfo.OpenClient()
 			},
 			AddQueryConnFlags: func(command *cobra.Command) {},
+			SendMsg:           chainInfo.SendMsg,
+			AddTxConnFlags:    AddTxFlags,
 		}
 
 		var (
@@ -122,6 +127,7 @@ func RemoteCommand(config *Config, configDir string) ([]*cobra.Command, error) {
 		chainCmd.Flags().BoolVar(&update, flagUpdate, false, "update the CLI commands for the selected chain (should be used after every chain upgrade)")
 		chainCmd.Flags().BoolVar(&reconfig, flagConfig, false, "re-configure the selected chain (allows choosing a new gRPC endpoint and refreshes data")
 		chainCmd.Flags().BoolVar(&insecure, flagInsecure, false, "allow re-configuring the selected chain using an insecure gRPC connection")
+		chainCmd.Flags().StringSlice(flagGRPCEndpoints, nil, "trusted gRPC endpoints used when re-configuring the selected chain, in order of preference")
 
 		if err := appOpts.EnhanceRootCommandWithBuilder(chainCmd, builder); err != nil {
 			return nil, err
@@ -146,31 +152,36 @@ func RemoteErrorCommand(config *Config, configDir string, chain string, chainCon
 	}
 
 	cmd.Flags().Bool(flagInsecure, chainConfig.GRPCEndpoints[0].Insecure, "allow setting up insecure gRPC connection")
+	cmd.Flags().StringSlice(flagGRPCEndpoints, nil, "trusted gRPC endpoints of the chain, in order of preference, instead of selecting one from the chain registry")
 
 	return cmd
 }
 
 func reconfigure(cmd *cobra.Command, config *Config, configDir, chain string) error {
 	insecure, _ := cmd.Flags().GetBool(flagInsecure)
+	endpoints, _ := cmd.Flags().GetStringSlice(flagGRPCEndpoints)
 
 	cmd.Printf("Configuring %s\n", chain)
-	endpoint, err := SelectGRPCEndpoints(chain)
-	if err != nil {
-		return err
+	if len(endpoints) == 0 {
+		endpoint, err := SelectGRPCEndpoints(chain)
+		if err != nil {
+			return err
+		}
+
+		endpoints = []string{endpoint}
 	}
 
-	cmd.Printf("%s endpoint selected\n", endpoint)
-	chainConfig := &ChainConfig{
-		GRPCEndpoints: []GRPCEndpoint{
-			{
-				Endpoint: endpoint,
-				Insecure: insecure,
-			},
-		},
+	chainConfig := &ChainConfig{}
+	for _, endpoint := range endpoints {
+		cmd.Printf("%s endpoint selected\n", endpoint)
+		chainConfig.GRPCEndpoints = append(chainConfig.GRPCEndpoints, GRPCEndpoint{
+			Endpoint: endpoint,
+			Insecure: insecure,
+		})
 	}
 
 	chainInfo := NewChainInfo(configDir, chain, chainConfig)
-	if err = chainInfo.Load(true); err != nil {
+	if err := chainInfo.Load(true); err != nil {
 		return err
 	}
 
@@ -0,0 +1,370 @@
+package internal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	reflectionv2alpha1 "cosmossdk.io/api/cosmos/base/reflection/v2alpha1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	_ "cosmossdk.io/api/cosmos/crypto/secp256k1" // register the pubkey type rendered by SIGN_MODE_TEXTUAL
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+	"cosmossdk.io/x/tx/signing/textual"
+	"github.com/cockroachdb/errors"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdksigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var flagYes string = "yes"
+
+// signModes are the sign modes hubl can sign with, by the value of their --sign-mode flag.
+var signModes = map[string]signingv1beta1.SignMode{
+	flags.SignModeDirect:  signingv1beta1.SignMode_SIGN_MODE_DIRECT,
+	flags.SignModeTextual: signingv1beta1.SignMode_SIGN_MODE_TEXTUAL,
+}
+
+// SigningInfo is the chain metadata needed to sign transactions.
+type SigningInfo struct {
+	ChainID      string
+	Bech32Prefix string
+	SignModes    []signingv1beta1.SignMode
+}
+
+// LoadSigningInfo fetches the signing metadata of the chain using the reflection and auth services.
+func (c *ChainInfo) LoadSigningInfo() (*SigningInfo, error) {
+	client, err := c.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	reflectionClient := reflectionv2alpha1.NewReflectionServiceClient(client)
+	chainRes, err := reflectionClient.GetChainDescriptor(c.Context, &reflectionv2alpha1.GetChainDescriptorRequest{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch the chain id of %s", c.Chain)
+	}
+
+	authnRes, err := reflectionClient.GetAuthnDescriptor(c.Context, &reflectionv2alpha1.GetAuthnDescriptorRequest{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch the sign modes of %s", c.Chain)
+	}
+
+	prefixRes, err := authv1beta1.NewQueryClient(client).Bech32Prefix(c.Context, &authv1beta1.Bech32PrefixRequest{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch the bech32 prefix of %s", c.Chain)
+	}
+
+	info := &SigningInfo{
+		ChainID:      chainRes.GetChain().GetId(),
+		Bech32Prefix: prefixRes.Bech32Prefix,
+	}
+	for _, signMode := range authnRes.GetAuthn().GetSignModes() {
+		info.SignModes = append(info.SignModes, signingv1beta1.SignMode(signMode.Number))
+	}
+
+	return info, nil
+}
+
+// accountInfo returns the account number and sequence of the provided address.
+func (c *ChainInfo) accountInfo(address string) (*authv1beta1.BaseAccount, error) {
+	client, err := c.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := authv1beta1.NewQueryClient(client)
+	infoRes, err := queryClient.AccountInfo(c.Context, &authv1beta1.QueryAccountInfoRequest{Address: address})
+	if err == nil {
+		return infoRes.Info, nil
+	}
+
+	// chains without the AccountInfo query only expose their accounts packed in an Any
+	res, err := queryClient.Account(c.Context, &authv1beta1.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't fetch the account %s, it must have received funds before signing transactions", address)
+	}
+
+	account := &authv1beta1.BaseAccount{}
+	if err := res.Account.UnmarshalTo(account); err != nil {
+		return nil, errors.Wrapf(err, "can't decode the account %s of type %s", address, res.Account.TypeUrl)
+	}
+	return account, nil
+}
+
+// coinMetadata queries the bank metadata of a denom for SIGN_MODE_TEXTUAL. Denoms without metadata are rendered
+// as is.
+func (c *ChainInfo) coinMetadata(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	client, err := c.OpenClient()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := bankv1beta1.NewQueryClient(client).DenomMetadata(ctx, &bankv1beta1.QueryDenomMetadataRequest{Denom: denom})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res.Metadata, nil
+}
+
+// AddTxFlags adds the flags used by SendMsg to a msg command.
+func AddTxFlags(cmd *cobra.Command) {
+	f := cmd.Flags()
+	f.String(flags.FlagFrom, "", "name or address of the key to sign with, defaults to the signer of the msg")
+	f.String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "select the keyring's backend (os|file|kwallet|pass|test|memory)")
+	f.String(flags.FlagSignMode, flags.SignModeDirect, "choose the sign mode (direct|textual)")
+	f.Uint64(flags.FlagGas, flags.DefaultGasLimit, "gas limit to set per-transaction")
+	f.String(flags.FlagFees, "", "fees to pay along with transaction; eg: 10uatom")
+	f.String(flags.FlagNote, "", "note to add a description to the transaction")
+	f.BoolP(flagYes, "y", false, "skip the transaction broadcasting prompt confirmation")
+}
+
+// SendMsg signs a tx containing the provided msg with a key of the hubl keyring and broadcasts it to the chain.
+// The signer field of the msg is set to the address of the key provided by the --from flag, or is used to find
+// the key if the flag is missing.
+func (c *ChainInfo) SendMsg(cmd *cobra.Command, msg protoreflect.Message) error {
+	signInfo, err := c.LoadSigningInfo()
+	if err != nil {
+		return err
+	}
+
+	signModeFlag, _ := cmd.Flags().GetString(flags.FlagSignMode)
+	signMode, ok := signModes[signModeFlag]
+	if !ok {
+		return fmt.Errorf("unsupported sign mode %s, expected one of direct or textual", signModeFlag)
+	}
+	if !containsSignMode(signInfo.SignModes, signMode) {
+		return fmt.Errorf("sign mode %s isn't supported by %s", signMode, c.Chain)
+	}
+
+	// the textual renderers only know the msg types built into hubl, not the dynamic types of the chain
+	if signMode == signingv1beta1.SignMode_SIGN_MODE_TEXTUAL {
+		if _, err := protoregistry.GlobalTypes.FindMessageByName(msg.Descriptor().FullName()); err != nil {
+			return fmt.Errorf("%s can't be signed with sign mode %s, use --%s %s", msg.Descriptor().FullName(), signMode, flags.FlagSignMode, flags.SignModeDirect)
+		}
+	}
+
+	backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	kr, err := OpenKeyring(c.ConfigDir, backend, cmd.InOrStdin())
+	if err != nil {
+		return err
+	}
+
+	from, _ := cmd.Flags().GetString(flags.FlagFrom)
+	signerField := autocli.MsgSignerField(msg.Descriptor())
+	if from == "" && signerField != nil {
+		from = msg.Get(signerField).String()
+	}
+	if from == "" {
+		return fmt.Errorf("the signer of %s is required, set it with the --%s flag", msg.Descriptor().FullName(), flags.FlagFrom)
+	}
+
+	record, err := findKey(kr, signInfo.Bech32Prefix, from)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	address, err := bech32.ConvertAndEncode(signInfo.Bech32Prefix, pubKey.Address())
+	if err != nil {
+		return err
+	}
+
+	if signerField != nil {
+		msg.Set(signerField, protoreflect.ValueOfString(address))
+	}
+
+	account, err := c.accountInfo(address)
+	if err != nil {
+		return err
+	}
+
+	// the type URL of the msg Any is the one of the chain, without the type.googleapis.com prefix set by anypb.New
+	marshalOptions := proto.MarshalOptions{Deterministic: true}
+	msgBytes, err := marshalOptions.Marshal(msg.Interface())
+	if err != nil {
+		return err
+	}
+	msgAny := &anypb.Any{TypeUrl: "/" + string(msg.Descriptor().FullName()), Value: msgBytes}
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return err
+	}
+
+	note, _ := cmd.Flags().GetString(flags.FlagNote)
+	gas, _ := cmd.Flags().GetUint64(flags.FlagGas)
+	fees, err := parseFees(cmd)
+	if err != nil {
+		return err
+	}
+
+	body := &txv1beta1.TxBody{
+		Messages: []*anypb.Any{msgAny},
+		Memo:     note,
+	}
+	authInfo := &txv1beta1.AuthInfo{
+		SignerInfos: []*txv1beta1.SignerInfo{
+			{
+				PublicKey: &anypb.Any{TypeUrl: pubKeyAny.TypeUrl, Value: pubKeyAny.Value},
+				ModeInfo: &txv1beta1.ModeInfo{
+					Sum: &txv1beta1.ModeInfo_Single_{Single: &txv1beta1.ModeInfo_Single{Mode: signMode}},
+				},
+				Sequence: account.Sequence,
+			},
+		},
+		Fee: &txv1beta1.Fee{
+			Amount:   fees,
+			GasLimit: gas,
+		},
+	}
+
+	bodyBytes, err := marshalOptions.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	authInfoBytes, err := marshalOptions.Marshal(authInfo)
+	if err != nil {
+		return err
+	}
+
+	signerData := signing.SignerData{
+		Address:       address,
+		ChainId:       signInfo.ChainID,
+		AccountNumber: account.AccountNumber,
+		Sequence:      account.Sequence,
+		PubKey:        authInfo.SignerInfos[0].PublicKey,
+	}
+	txData := signing.TxData{
+		Body:          body,
+		AuthInfo:      authInfo,
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+	}
+
+	handlerMap := signing.NewHandlerMap(direct.SignModeHandler{}, textual.NewSignModeHandler(c.coinMetadata))
+	signBytes, err := handlerMap.GetSignBytes(c.Context, signMode, signerData, txData)
+	if err != nil {
+		return errors.Wrapf(err, "can't build the %s sign bytes", signMode)
+	}
+
+	if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+		txJSON, err := protojson.MarshalOptions{Resolver: dynamicTypeResolver{c}}.Marshal(&txv1beta1.Tx{Body: body, AuthInfo: authInfo})
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("%s\n\n", txJSON)
+		ok, err := input.GetConfirmation(fmt.Sprintf("confirm transaction on %s before signing and broadcasting", signInfo.ChainID), bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			cmd.PrintErrln("canceled transaction")
+			return nil
+		}
+	}
+
+	signature, _, err := kr.Sign(record.Name, signBytes, sdksigning.SignMode(signMode))
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := proto.Marshal(&txv1beta1.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{signature},
+	})
+	if err != nil {
+		return err
+	}
+
+	client, err := c.OpenClient()
+	if err != nil {
+		return err
+	}
+
+	res, err := txv1beta1.NewServiceClient(client).BroadcastTx(c.Context, &txv1beta1.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txv1beta1.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return err
+	}
+
+	bz, err := protojson.Marshal(res.TxResponse)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(bz))
+	return nil
+}
+
+// findKey finds a key of the keyring by name, or by address if no key has this name.
+func findKey(kr keyring.Keyring, prefix, nameOrAddress string) (*keyring.Record, error) {
+	record, err := kr.Key(nameOrAddress)
+	if err == nil {
+		return record, nil
+	}
+
+	hrp, bz, addrErr := bech32.DecodeAndConvert(nameOrAddress)
+	if addrErr != nil || hrp != prefix {
+		return nil, err
+	}
+
+	return kr.KeyByAddress(sdk.AccAddress(bz))
+}
+
+func parseFees(cmd *cobra.Command) ([]*basev1beta1.Coin, error) {
+	feesFlag, _ := cmd.Flags().GetString(flags.FlagFees)
+	if strings.TrimSpace(feesFlag) == "" {
+		return nil, nil
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(feesFlag)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid fees %s", feesFlag)
+	}
+
+	fees := make([]*basev1beta1.Coin, len(coins))
+	for i, coin := range coins {
+		fees[i] = &basev1beta1.Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return fees, nil
+}
+
+func containsSignMode(signModes []signingv1beta1.SignMode, signMode signingv1beta1.SignMode) bool {
+	for _, mode := range signModes {
+		if mode == signMode {
+			return true
+		}
+	}
+	return false
+}
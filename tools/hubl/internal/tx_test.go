@@ -0,0 +1,272 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	reflectionv2alpha1 "cosmossdk.io/api/cosmos/base/reflection/v2alpha1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/v3/assert"
+)
+
+const (
+	testChainID = "test-chain"
+	testPrefix  = "test"
+)
+
+// testChain is a fake chain serving the services used to sign and broadcast transactions.
+type testChain struct {
+	reflectionv2alpha1.UnimplementedReflectionServiceServer
+	authv1beta1.UnimplementedQueryServer
+	txv1beta1.UnimplementedServiceServer
+
+	signModes   []signingv1beta1.SignMode
+	account     *authv1beta1.BaseAccount
+	broadcasted [][]byte
+}
+
+func (s *testChain) GetChainDescriptor(context.Context, *reflectionv2alpha1.GetChainDescriptorRequest) (*reflectionv2alpha1.GetChainDescriptorResponse, error) {
+	return &reflectionv2alpha1.GetChainDescriptorResponse{Chain: &reflectionv2alpha1.ChainDescriptor{Id: testChainID}}, nil
+}
+
+func (s *testChain) GetAuthnDescriptor(context.Context, *reflectionv2alpha1.GetAuthnDescriptorRequest) (*reflectionv2alpha1.GetAuthnDescriptorResponse, error) {
+	authn := &reflectionv2alpha1.AuthnDescriptor{}
+	for _, signMode := range s.signModes {
+		authn.SignModes = append(authn.SignModes, &reflectionv2alpha1.SigningModeDescriptor{Name: signMode.String(), Number: int32(signMode)})
+	}
+	return &reflectionv2alpha1.GetAuthnDescriptorResponse{Authn: authn}, nil
+}
+
+func (s *testChain) Bech32Prefix(context.Context, *authv1beta1.Bech32PrefixRequest) (*authv1beta1.Bech32PrefixResponse, error) {
+	return &authv1beta1.Bech32PrefixResponse{Bech32Prefix: testPrefix}, nil
+}
+
+func (s *testChain) AccountInfo(_ context.Context, req *authv1beta1.QueryAccountInfoRequest) (*authv1beta1.QueryAccountInfoResponse, error) {
+	account := proto.Clone(s.account).(*authv1beta1.BaseAccount)
+	account.Address = req.Address
+	return &authv1beta1.QueryAccountInfoResponse{Info: account}, nil
+}
+
+func (s *testChain) BroadcastTx(_ context.Context, req *txv1beta1.BroadcastTxRequest) (*txv1beta1.BroadcastTxResponse, error) {
+	s.broadcasted = append(s.broadcasted, req.TxBytes)
+	return &txv1beta1.BroadcastTxResponse{TxResponse: &abciv1beta1.TxResponse{Txhash: "TXHASH"}}, nil
+}
+
+// startTestServer serves the registered services on a local port and returns its address.
+func startTestServer(t *testing.T, register func(*grpc.Server)) string {
+	t.Helper()
+
+	server := grpc.NewServer()
+	register(server)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func newTestChainInfo(t *testing.T, endpoints ...string) *ChainInfo {
+	t.Helper()
+
+	config := &ChainConfig{}
+	for _, endpoint := range endpoints {
+		config.GRPCEndpoints = append(config.GRPCEndpoints, GRPCEndpoint{Endpoint: endpoint, Insecure: true})
+	}
+
+	chainInfo := NewChainInfo(t.TempDir(), "test", config)
+	t.Cleanup(func() {
+		if chainInfo.client != nil {
+			_ = chainInfo.client.Close()
+		}
+	})
+	return chainInfo
+}
+
+func sendMsgCommand(chainInfo *ChainInfo, msg *bankv1beta1.MsgSend, args ...string) (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{
+		Use: "send",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return chainInfo.SendMsg(cmd, msg.ProtoReflect())
+		},
+	}
+	AddTxFlags(cmd)
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(append([]string{"--" + flags.FlagKeyringBackend, keyring.BackendTest, "--" + flagYes}, args...))
+	return cmd, out
+}
+
+func TestSendMsg(t *testing.T) {
+	chain := &testChain{
+		signModes: []signingv1beta1.SignMode{signingv1beta1.SignMode_SIGN_MODE_DIRECT},
+		account:   &authv1beta1.BaseAccount{AccountNumber: 7, Sequence: 3},
+	}
+	chainInfo := newTestChainInfo(t, startTestServer(t, func(server *grpc.Server) {
+		reflectionv2alpha1.RegisterReflectionServiceServer(server, chain)
+		authv1beta1.RegisterQueryServer(server, chain)
+		txv1beta1.RegisterServiceServer(server, chain)
+	}))
+
+	kr, err := OpenKeyring(chainInfo.ConfigDir, keyring.BackendTest, nil)
+	assert.NilError(t, err)
+	record, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	assert.NilError(t, err)
+	pubKey, err := record.GetPubKey()
+	assert.NilError(t, err)
+	address, err := bech32.ConvertAndEncode(testPrefix, pubKey.Address())
+	assert.NilError(t, err)
+
+	msg := &bankv1beta1.MsgSend{
+		ToAddress: address,
+		Amount:    []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	}
+	cmd, out := sendMsgCommand(chainInfo, msg, "--"+flags.FlagFrom, "alice", "--"+flags.FlagFees, "5stake", "--"+flags.FlagNote, "memo")
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, strings.Contains(out.String(), "TXHASH"), out.String())
+	assert.Equal(t, len(chain.broadcasted), 1)
+
+	txRaw := &txv1beta1.TxRaw{}
+	assert.NilError(t, proto.Unmarshal(chain.broadcasted[0], txRaw))
+	body := &txv1beta1.TxBody{}
+	assert.NilError(t, proto.Unmarshal(txRaw.BodyBytes, body))
+	authInfo := &txv1beta1.AuthInfo{}
+	assert.NilError(t, proto.Unmarshal(txRaw.AuthInfoBytes, authInfo))
+
+	// the msg is packed with the type URL of the chain, and its signer is set from the key
+	assert.Equal(t, body.Memo, "memo")
+	assert.Equal(t, len(body.Messages), 1)
+	assert.Equal(t, body.Messages[0].TypeUrl, "/cosmos.bank.v1beta1.MsgSend")
+	sentMsg := &bankv1beta1.MsgSend{}
+	assert.NilError(t, proto.Unmarshal(body.Messages[0].Value, sentMsg))
+	assert.Equal(t, sentMsg.FromAddress, address)
+	assert.Equal(t, sentMsg.ToAddress, address)
+
+	assert.Equal(t, authInfo.SignerInfos[0].PublicKey.TypeUrl, "/cosmos.crypto.secp256k1.PubKey")
+	assert.Equal(t, authInfo.SignerInfos[0].Sequence, uint64(3))
+	assert.Equal(t, authInfo.Fee.GasLimit, uint64(flags.DefaultGasLimit))
+	assert.Equal(t, len(authInfo.Fee.Amount), 1)
+	assert.Equal(t, authInfo.Fee.Amount[0].Denom, "stake")
+	assert.Equal(t, authInfo.Fee.Amount[0].Amount, "5")
+
+	// the signature is the one of the direct sign doc
+	signDoc, err := proto.MarshalOptions{Deterministic: true}.Marshal(&txv1beta1.SignDoc{
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: txRaw.AuthInfoBytes,
+		ChainId:       testChainID,
+		AccountNumber: 7,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(txRaw.Signatures), 1)
+	assert.Assert(t, pubKey.VerifySignature(signDoc, txRaw.Signatures[0]))
+
+	// the key is found from the signer of the msg without the --from flag
+	msg.FromAddress = address
+	cmd, _ = sendMsgCommand(chainInfo, msg)
+	assert.NilError(t, cmd.Execute())
+	assert.Equal(t, len(chain.broadcasted), 2)
+
+	// the sign modes not supported by hubl or by the chain are rejected
+	cmd, _ = sendMsgCommand(chainInfo, msg, "--"+flags.FlagSignMode, flags.SignModeLegacyAminoJSON)
+	assert.ErrorContains(t, cmd.Execute(), "unsupported sign mode")
+	cmd, _ = sendMsgCommand(chainInfo, msg, "--"+flags.FlagSignMode, flags.SignModeTextual)
+	assert.ErrorContains(t, cmd.Execute(), "isn't supported by test")
+
+	// the signer is required
+	msg.FromAddress = ""
+	cmd, _ = sendMsgCommand(chainInfo, msg)
+	assert.ErrorContains(t, cmd.Execute(), "the signer of cosmos.bank.v1beta1.MsgSend is required")
+	assert.Equal(t, len(chain.broadcasted), 2)
+}
+
+func TestFindKey(t *testing.T) {
+	kr, err := OpenKeyring(t.TempDir(), keyring.BackendMemory, nil)
+	assert.NilError(t, err)
+	record, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	assert.NilError(t, err)
+	pubKey, err := record.GetPubKey()
+	assert.NilError(t, err)
+	address, err := bech32.ConvertAndEncode(testPrefix, pubKey.Address())
+	assert.NilError(t, err)
+	otherAddress, err := bech32.ConvertAndEncode("other", pubKey.Address())
+	assert.NilError(t, err)
+
+	testCases := []struct {
+		name          string
+		nameOrAddress string
+		expErr        bool
+	}{
+		{"by name", "alice", false},
+		{"by address", address, false},
+		{"by address with another prefix", otherAddress, true},
+		{"unknown name", "bob", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := findKey(kr, testPrefix, tc.nameOrAddress)
+			if tc.expErr {
+				assert.ErrorContains(t, err, "not found")
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, found.Name, "alice")
+		})
+	}
+}
+
+func TestParseFees(t *testing.T) {
+	testCases := []struct {
+		name    string
+		fees    string
+		expFees []*basev1beta1.Coin
+		expErr  string
+	}{
+		{"no fees", "", nil, ""},
+		{"blank fees", "  ", nil, ""},
+		{"single coin", "10stake", []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}}, ""},
+		{"sorted coins", "10stake,5atom", []*basev1beta1.Coin{{Denom: "atom", Amount: "5"}, {Denom: "stake", Amount: "10"}}, ""},
+		{"missing denom", "10", nil, "invalid fees 10"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			AddTxFlags(cmd)
+			assert.NilError(t, cmd.Flags().Set(flags.FlagFees, tc.fees))
+
+			fees, err := parseFees(cmd)
+			if tc.expErr != "" {
+				assert.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Equal(t, len(fees), len(tc.expFees))
+			for i, fee := range fees {
+				assert.Assert(t, proto.Equal(fee, tc.expFees[i]), "fee %d: %v", i, fee)
+			}
+		})
+	}
+}
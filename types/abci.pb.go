@@ -273,6 +273,12 @@ type GasInfo struct {
 	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
 	// GasUsed is the amount of gas actually consumed.
 	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_profile breaks the gas consumed by the KV store operations down by store,
+	// operation and message type. It is only populated in simulations, when gas
+	// profiling is enabled.
+	//
+	// Since: cosmos-sdk 0.48
+	GasProfile []*GasProfileEntry `protobuf:"bytes,3,rep,name=gas_profile,json=gasProfile,proto3" json:"gas_profile,omitempty"`
 }
 
 func (m *GasInfo) Reset()      { *m = GasInfo{} }
@@ -321,6 +327,98 @@ func (m *GasInfo) GetGasUsed() uint64 {
 	return 0
 }
 
+func (m *GasInfo) GetGasProfile() []*GasProfileEntry {
+	if m != nil {
+		return m.GasProfile
+	}
+	return nil
+}
+
+// GasProfileEntry is the gas consumed by the operations of a type on a KV store,
+// while executing the messages of a type.
+//
+// Since: cosmos-sdk 0.48
+type GasProfileEntry struct {
+	// store_key is the name of the KV store.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// operation is the type of the operation: read, write, has, delete or iter.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// msg_type_url is the type URL of the message, empty for the operations made
+	// outside of the messages, e.g. by the ante and post handlers.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas is the gas consumed by the operations.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	// count is the number of gas charges of the operations.
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GasProfileEntry) Reset()      { *m = GasProfileEntry{} }
+func (*GasProfileEntry) ProtoMessage() {}
+func (*GasProfileEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+}
+func (m *GasProfileEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasProfileEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileEntry.Merge(m, src)
+}
+func (m *GasProfileEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasProfileEntry proto.InternalMessageInfo
+
+func (m *GasProfileEntry) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *GasProfileEntry) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *GasProfileEntry) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // Result is the union of ResponseFormat and ResponseCheckTx.
 type Result struct {
 	// Data is any data returned from message or handler execution. It MUST be
@@ -342,7 +440,7 @@ type Result struct {
 func (m *Result) Reset()      { *m = Result{} }
 func (*Result) ProtoMessage() {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{5}
+	return fileDescriptor_4e37629bc7eb0df8, []int{6}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +479,7 @@ type SimulationResponse struct {
 func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
 func (*SimulationResponse) ProtoMessage() {}
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{6}
+	return fileDescriptor_4e37629bc7eb0df8, []int{7}
 }
 func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +527,7 @@ type MsgData struct {
 func (m *MsgData) Reset()      { *m = MsgData{} }
 func (*MsgData) ProtoMessage() {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{7}
+	return fileDescriptor_4e37629bc7eb0df8, []int{8}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +584,7 @@ type TxMsgData struct {
 func (m *TxMsgData) Reset()      { *m = TxMsgData{} }
 func (*TxMsgData) ProtoMessage() {}
 func (*TxMsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{8}
+	return fileDescriptor_4e37629bc7eb0df8, []int{9}
 }
 func (m *TxMsgData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +647,7 @@ type SearchTxsResult struct {
 func (m *SearchTxsResult) Reset()      { *m = SearchTxsResult{} }
 func (*SearchTxsResult) ProtoMessage() {}
 func (*SearchTxsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{9}
+	return fileDescriptor_4e37629bc7eb0df8, []int{10}
 }
 func (m *SearchTxsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +737,7 @@ type SearchBlocksResult struct {
 func (m *SearchBlocksResult) Reset()      { *m = SearchBlocksResult{} }
 func (*SearchBlocksResult) ProtoMessage() {}
 func (*SearchBlocksResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4e37629bc7eb0df8, []int{10}
+	return fileDescriptor_4e37629bc7eb0df8, []int{11}
 }
 func (m *SearchBlocksResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,6 +814,7 @@ func init() {
 	proto.RegisterType((*StringEvent)(nil), "cosmos.base.abci.v1beta1.StringEvent")
 	proto.RegisterType((*Attribute)(nil), "cosmos.base.abci.v1beta1.Attribute")
 	proto.RegisterType((*GasInfo)(nil), "cosmos.base.abci.v1beta1.GasInfo")
+	proto.RegisterType((*GasProfileEntry)(nil), "cosmos.base.abci.v1beta1.GasProfileEntry")
 	proto.RegisterType((*Result)(nil), "cosmos.base.abci.v1beta1.Result")
 	proto.RegisterType((*SimulationResponse)(nil), "cosmos.base.abci.v1beta1.SimulationResponse")
 	proto.RegisterType((*MsgData)(nil), "cosmos.base.abci.v1beta1.MsgData")
@@ -729,68 +828,73 @@ func init() {
 }
 
 var fileDescriptor_4e37629bc7eb0df8 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x1b, 0xb7,
-	0x13, 0xd5, 0x6a, 0x37, 0x2b, 0x8b, 0xb2, 0x7e, 0xf9, 0x81, 0x30, 0x6c, 0x3a, 0x4d, 0x25, 0x55,
-	0x49, 0x01, 0xa1, 0x40, 0x57, 0x88, 0x13, 0x14, 0x4d, 0x4e, 0x89, 0xdc, 0x7f, 0x06, 0x92, 0x1e,
-	0xd6, 0x0a, 0x0a, 0xf4, 0x22, 0x50, 0x12, 0x43, 0x2d, 0xac, 0x5d, 0x0a, 0x4b, 0xca, 0x96, 0x6f,
-	0xbd, 0xb5, 0xc7, 0x9e, 0x7a, 0xee, 0xb5, 0xfd, 0x24, 0x39, 0xf4, 0xe0, 0xa3, 0x0f, 0x81, 0xdb,
-	0xda, 0xb7, 0x7e, 0x8a, 0x62, 0x86, 0xd4, 0x9f, 0xd4, 0x95, 0x9b, 0x93, 0x87, 0x6f, 0x86, 0xd4,
-	0xbc, 0x37, 0x6f, 0x49, 0x93, 0x7b, 0x03, 0xa5, 0x53, 0xa5, 0xdb, 0x7d, 0xae, 0x45, 0x9b, 0xf7,
-	0x07, 0x49, 0xfb, 0xf8, 0x41, 0x5f, 0x18, 0xfe, 0x00, 0x17, 0xd1, 0x24, 0x57, 0x46, 0x51, 0x66,
-	0x8b, 0x22, 0x28, 0x8a, 0x10, 0x77, 0x45, 0x77, 0xb6, 0xa4, 0x92, 0x0a, 0x8b, 0xda, 0x10, 0xd9,
-	0xfa, 0x3b, 0xef, 0x19, 0x91, 0x0d, 0x45, 0x9e, 0x26, 0x99, 0xb1, 0x67, 0x9a, 0xd3, 0x89, 0xd0,
-	0x2e, 0x79, 0x77, 0x25, 0x89, 0x78, 0xbb, 0x3f, 0x56, 0x83, 0x23, 0x97, 0xdd, 0x95, 0x4a, 0xc9,
-	0xb1, 0x68, 0xe3, 0xaa, 0x3f, 0x7d, 0xd5, 0xe6, 0xd9, 0xa9, 0x4d, 0x35, 0x7f, 0xf3, 0x09, 0xe9,
-	0xce, 0x62, 0xa1, 0x27, 0x2a, 0xd3, 0x82, 0x6e, 0x93, 0x70, 0x24, 0x12, 0x39, 0x32, 0xcc, 0x6b,
-	0x78, 0x2d, 0x3f, 0x76, 0x2b, 0xda, 0x24, 0xa1, 0x99, 0x8d, 0xb8, 0x1e, 0xb1, 0x62, 0xc3, 0x6b,
-	0x95, 0x3b, 0xe4, 0xf2, 0xa2, 0x1e, 0x76, 0x67, 0x5f, 0x71, 0x3d, 0x8a, 0x5d, 0x86, 0xde, 0x25,
-	0xe5, 0x81, 0x1a, 0x0a, 0x3d, 0xe1, 0x03, 0xc1, 0x7c, 0x28, 0x8b, 0x97, 0x00, 0xa5, 0x24, 0x80,
-	0x05, 0x0b, 0x1a, 0x5e, 0xab, 0x1a, 0x63, 0x0c, 0xd8, 0x90, 0x1b, 0xce, 0x6e, 0x61, 0x31, 0xc6,
-	0x74, 0x87, 0x94, 0x72, 0x7e, 0xd2, 0x1b, 0x2b, 0xc9, 0x42, 0x84, 0xc3, 0x9c, 0x9f, 0x3c, 0x57,
-	0x92, 0xbe, 0x24, 0xc1, 0x58, 0x49, 0xcd, 0x4a, 0x0d, 0xbf, 0x55, 0xd9, 0x6b, 0x45, 0xeb, 0xe4,
-	0x8b, 0x9e, 0x75, 0xf6, 0x0f, 0x5e, 0x08, 0xad, 0xb9, 0x14, 0xcf, 0x95, 0xec, 0xec, 0xbc, 0xbe,
-	0xa8, 0x17, 0x7e, 0xfd, 0xbd, 0x7e, 0xfb, 0x6d, 0x5c, 0xc7, 0x78, 0x1c, 0xf4, 0x90, 0x64, 0xaf,
-	0x14, 0xdb, 0xb0, 0x3d, 0x40, 0x4c, 0xdf, 0x27, 0x44, 0x72, 0xdd, 0x3b, 0xe1, 0x99, 0x11, 0x43,
-	0x56, 0x46, 0x25, 0xca, 0x92, 0xeb, 0x6f, 0x10, 0xa0, 0xbb, 0x64, 0x03, 0xd2, 0x53, 0x2d, 0x86,
-	0x8c, 0x60, 0xb2, 0x24, 0xb9, 0x7e, 0xa9, 0xc5, 0x90, 0xde, 0x27, 0x45, 0x33, 0x63, 0x95, 0x86,
-	0xd7, 0xaa, 0xec, 0x6d, 0x45, 0x56, 0xf6, 0x68, 0x2e, 0x7b, 0xf4, 0x2c, 0x3b, 0x8d, 0x8b, 0x66,
-	0x06, 0x4a, 0x99, 0x24, 0x15, 0xda, 0xf0, 0x74, 0xc2, 0x36, 0xad, 0x52, 0x0b, 0x80, 0x3e, 0x22,
-	0xa1, 0x38, 0x16, 0x99, 0xd1, 0xac, 0x8a, 0x54, 0xb7, 0xa3, 0xe5, 0x70, 0x2d, 0xd3, 0xcf, 0x21,
-	0xdd, 0x09, 0x80, 0x58, 0xec, 0x6a, 0x9f, 0x04, 0x3f, 0xfc, 0x5c, 0x2f, 0x34, 0x7f, 0xf1, 0xc8,
-	0xff, 0xde, 0xe6, 0x49, 0x3f, 0x22, 0xe5, 0x54, 0xcb, 0x5e, 0x92, 0x0d, 0xc5, 0x0c, 0xa7, 0x5a,
-	0xed, 0x54, 0xff, 0xba, 0xa8, 0x2f, 0xc1, 0x78, 0x23, 0xd5, 0xf2, 0x00, 0x22, 0xfa, 0x7f, 0xe2,
-	0x83, 0xf0, 0x38, 0xe3, 0x18, 0x42, 0x7a, 0xb8, 0x68, 0xc6, 0xc7, 0x66, 0x3e, 0x5c, 0xaf, 0xfb,
-	0xa1, 0xc9, 0x93, 0x4c, 0xda, 0xde, 0xb6, 0x9c, 0xe8, 0x9b, 0x2b, 0xa0, 0x5e, 0xf6, 0xfa, 0xdd,
-	0x9b, 0x86, 0xd7, 0xcc, 0x49, 0x65, 0x25, 0x0b, 0x83, 0x00, 0xe7, 0x62, 0x8b, 0xe5, 0x18, 0x63,
-	0x7a, 0x40, 0x08, 0x37, 0x26, 0x4f, 0xfa, 0x53, 0x23, 0x34, 0x2b, 0x62, 0x07, 0xf7, 0x6e, 0x98,
-	0xfc, 0xbc, 0xd6, 0x69, 0xb3, 0xb2, 0xd9, 0xfd, 0xe6, 0x43, 0x52, 0x5e, 0x14, 0x01, 0xdb, 0x23,
-	0x71, 0xea, 0x7e, 0x10, 0x42, 0xba, 0x45, 0x6e, 0x1d, 0xf3, 0xf1, 0x54, 0x38, 0x05, 0xec, 0xa2,
-	0xb9, 0x4f, 0x4a, 0x5f, 0x72, 0x7d, 0x70, 0xdd, 0x19, 0xb0, 0x33, 0x58, 0xe7, 0x8c, 0x22, 0x26,
-	0xe7, 0xce, 0x80, 0xc9, 0x84, 0xb1, 0xd0, 0xd3, 0xb1, 0xa1, 0xdb, 0xce, 0xf6, 0xb0, 0x7d, 0xb3,
-	0x53, 0x64, 0x9e, 0xb3, 0xfe, 0x75, 0xf5, 0x1f, 0xfd, 0x43, 0xfd, 0x77, 0xb2, 0x02, 0x7d, 0x4c,
-	0xaa, 0x30, 0xdc, 0xdc, 0x7d, 0xd4, 0x9a, 0x05, 0xb8, 0xf9, 0xdf, 0xfd, 0xb8, 0x99, 0x6a, 0x39,
-	0xff, 0xfc, 0xe7, 0x2e, 0xfa, 0xc9, 0x23, 0xf4, 0x30, 0x49, 0xa7, 0x63, 0x6e, 0x12, 0x95, 0x2d,
-	0x2e, 0x87, 0x2f, 0x2c, 0x3b, 0xfc, 0x5c, 0x3c, 0xb4, 0xf8, 0x07, 0xeb, 0x67, 0xe1, 0x14, 0xeb,
-	0x6c, 0x40, 0x6b, 0x67, 0x17, 0x75, 0x0f, 0xa5, 0x40, 0x11, 0x3f, 0x25, 0x61, 0x8e, 0x4a, 0x20,
-	0xd5, 0xca, 0x5e, 0x63, 0xfd, 0x29, 0x56, 0xb1, 0xd8, 0xd5, 0x37, 0x9f, 0x92, 0xd2, 0x0b, 0x2d,
-	0x3f, 0x03, 0xb1, 0x76, 0x09, 0xd8, 0xb6, 0xb7, 0x62, 0x99, 0x52, 0xaa, 0x65, 0x17, 0x5c, 0x33,
-	0xbf, 0x56, 0xe0, 0xf4, 0x4d, 0xab, 0xed, 0x93, 0x10, 0xc6, 0xcf, 0xbc, 0xe6, 0xf7, 0x1e, 0x29,
-	0x77, 0x67, 0xf3, 0x43, 0x1e, 0x2f, 0x26, 0xe1, 0xdf, 0xcc, 0xc6, 0x6d, 0x58, 0x19, 0xd6, 0x35,
-	0x91, 0x8b, 0xef, 0x2e, 0x32, 0x5a, 0xf1, 0x8d, 0x47, 0x6e, 0x1f, 0x0a, 0x9e, 0x0f, 0x46, 0xdd,
-	0x99, 0x76, 0xce, 0xa8, 0x93, 0x8a, 0x51, 0x86, 0x8f, 0x7b, 0x03, 0x35, 0xcd, 0x8c, 0xf3, 0x17,
-	0x41, 0x68, 0x1f, 0x10, 0x30, 0xa8, 0x4d, 0x59, 0x77, 0xd9, 0x05, 0x6c, 0x9b, 0x70, 0x29, 0x7a,
-	0xd9, 0x34, 0xed, 0x8b, 0x1c, 0xef, 0xde, 0x20, 0x26, 0x00, 0x7d, 0x8d, 0x08, 0xd8, 0x16, 0x0b,
-	0xf0, 0x24, 0xbc, 0x82, 0x83, 0xb8, 0x0c, 0x48, 0x17, 0x00, 0x38, 0x75, 0x9c, 0xa4, 0x89, 0xc1,
-	0x8b, 0x38, 0x88, 0xed, 0x82, 0x7e, 0x42, 0x7c, 0x33, 0xd3, 0x2c, 0x44, 0x5e, 0xf7, 0xd7, 0x6b,
-	0xb3, 0x7c, 0x3e, 0x62, 0xd8, 0xe0, 0xe8, 0x9d, 0x83, 0x87, 0x90, 0x5e, 0x07, 0x5e, 0xa2, 0x1b,
-	0x18, 0xfa, 0xeb, 0x19, 0xfa, 0x37, 0x30, 0xf4, 0xff, 0x83, 0xa1, 0xbf, 0x96, 0xa1, 0x3f, 0x67,
-	0xd8, 0x26, 0x21, 0x3e, 0x93, 0x73, 0x92, 0x3b, 0xab, 0x9f, 0x97, 0x7d, 0x5e, 0xb1, 0xf9, 0xd8,
-	0x95, 0x59, 0x6a, 0x9d, 0xa7, 0xe7, 0x7f, 0xd6, 0x0a, 0xaf, 0x2f, 0x6b, 0xde, 0xd9, 0x65, 0xcd,
-	0xfb, 0xe3, 0xb2, 0xe6, 0xfd, 0x78, 0x55, 0x2b, 0x9c, 0x5d, 0xd5, 0x0a, 0xe7, 0x57, 0xb5, 0xc2,
-	0xb7, 0x4d, 0x99, 0x98, 0xd1, 0xb4, 0x1f, 0x0d, 0x54, 0xda, 0x76, 0xff, 0x07, 0xd8, 0x3f, 0x1f,
-	0xeb, 0xe1, 0x91, 0x7d, 0x9c, 0xfb, 0x21, 0xba, 0xe3, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0x51, 0x55, 0xd9, 0x29, 0x08, 0x00, 0x00,
+	// 1054 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x7a, 0xdd, 0xb5, 0xfd, 0x9c, 0x50, 0x34, 0x8a, 0x92, 0x4d, 0x5b, 0x6c, 0xe3, 0x16,
+	0xc9, 0x20, 0x61, 0xab, 0x69, 0x85, 0x68, 0x4f, 0xad, 0x4b, 0x29, 0x81, 0x16, 0xa1, 0x8d, 0x23,
+	0x24, 0x2e, 0xd6, 0xd8, 0x9e, 0x8c, 0x57, 0x59, 0xef, 0x58, 0x3b, 0xe3, 0xc4, 0xbe, 0x71, 0x83,
+	0x1b, 0x9c, 0x7a, 0xe6, 0x0a, 0x7f, 0x49, 0x0f, 0x1c, 0x72, 0xcc, 0xa1, 0x0a, 0x90, 0xdc, 0xf8,
+	0x2b, 0xd0, 0x7b, 0x3b, 0xfe, 0xd1, 0x46, 0x0e, 0x3d, 0x79, 0xe6, 0x7b, 0x6f, 0xc6, 0xef, 0xfb,
+	0xde, 0xf7, 0x76, 0x17, 0x6e, 0xf7, 0x94, 0x1e, 0x2a, 0xdd, 0xec, 0x72, 0x2d, 0x9a, 0xbc, 0xdb,
+	0x0b, 0x9b, 0x47, 0x77, 0xbb, 0xc2, 0xf0, 0xbb, 0xb4, 0x69, 0x8c, 0x12, 0x65, 0x14, 0xf3, 0xd3,
+	0xa4, 0x06, 0x26, 0x35, 0x08, 0xb7, 0x49, 0x37, 0x36, 0xa4, 0x92, 0x8a, 0x92, 0x9a, 0xb8, 0x4a,
+	0xf3, 0x6f, 0xdc, 0x34, 0x22, 0xee, 0x8b, 0x64, 0x18, 0xc6, 0x26, 0xbd, 0xd3, 0x4c, 0x47, 0x42,
+	0xdb, 0xe0, 0xad, 0xa5, 0x20, 0xe1, 0xcd, 0x6e, 0xa4, 0x7a, 0x87, 0x36, 0xba, 0x2d, 0x95, 0x92,
+	0x91, 0x68, 0xd2, 0xae, 0x3b, 0x3e, 0x68, 0xf2, 0x78, 0x9a, 0x86, 0x6a, 0x7f, 0xba, 0x00, 0xed,
+	0x49, 0x20, 0xf4, 0x48, 0xc5, 0x5a, 0xb0, 0x4d, 0xf0, 0x06, 0x22, 0x94, 0x03, 0xe3, 0x3b, 0x55,
+	0xa7, 0xee, 0x06, 0x76, 0xc7, 0x6a, 0xe0, 0x99, 0xc9, 0x80, 0xeb, 0x81, 0x9f, 0xad, 0x3a, 0xf5,
+	0x62, 0x0b, 0xce, 0xcf, 0x2a, 0x5e, 0x7b, 0xf2, 0x15, 0xd7, 0x83, 0xc0, 0x46, 0xd8, 0x2d, 0x28,
+	0xf6, 0x54, 0x5f, 0xe8, 0x11, 0xef, 0x09, 0xdf, 0xc5, 0xb4, 0x60, 0x01, 0x30, 0x06, 0x39, 0xdc,
+	0xf8, 0xb9, 0xaa, 0x53, 0x5f, 0x0f, 0x68, 0x8d, 0x58, 0x9f, 0x1b, 0xee, 0x5f, 0xa3, 0x64, 0x5a,
+	0xb3, 0x2d, 0xc8, 0x27, 0xfc, 0xb8, 0x13, 0x29, 0xe9, 0x7b, 0x04, 0x7b, 0x09, 0x3f, 0x7e, 0xae,
+	0x24, 0xdb, 0x87, 0x5c, 0xa4, 0xa4, 0xf6, 0xf3, 0x55, 0xb7, 0x5e, 0xda, 0xa9, 0x37, 0x56, 0xc9,
+	0xd7, 0x78, 0xdc, 0x7a, 0xb2, 0xfb, 0x42, 0x68, 0xcd, 0xa5, 0x78, 0xae, 0x64, 0x6b, 0xeb, 0xd5,
+	0x59, 0x25, 0xf3, 0xc7, 0x5f, 0x95, 0xeb, 0x6f, 0xe2, 0x3a, 0xa0, 0xeb, 0xb0, 0x86, 0x30, 0x3e,
+	0x50, 0x7e, 0x21, 0xad, 0x01, 0xd7, 0xec, 0x03, 0x00, 0xc9, 0x75, 0xe7, 0x98, 0xc7, 0x46, 0xf4,
+	0xfd, 0x22, 0x29, 0x51, 0x94, 0x5c, 0x7f, 0x4f, 0x00, 0xdb, 0x86, 0x02, 0x86, 0xc7, 0x5a, 0xf4,
+	0x7d, 0xa0, 0x60, 0x5e, 0x72, 0xbd, 0xaf, 0x45, 0x9f, 0xdd, 0x81, 0xac, 0x99, 0xf8, 0xa5, 0xaa,
+	0x53, 0x2f, 0xed, 0x6c, 0x34, 0x52, 0xd9, 0x1b, 0x33, 0xd9, 0x1b, 0x8f, 0xe3, 0x69, 0x90, 0x35,
+	0x13, 0x54, 0xca, 0x84, 0x43, 0xa1, 0x0d, 0x1f, 0x8e, 0xfc, 0xb5, 0x54, 0xa9, 0x39, 0xc0, 0xee,
+	0x83, 0x27, 0x8e, 0x44, 0x6c, 0xb4, 0xbf, 0x4e, 0x54, 0x37, 0x1b, 0x8b, 0xe6, 0xa6, 0x4c, 0x9f,
+	0x62, 0xb8, 0x95, 0x43, 0x62, 0x81, 0xcd, 0x7d, 0x98, 0xfb, 0xf9, 0xb7, 0x4a, 0xa6, 0xf6, 0xbb,
+	0x03, 0xef, 0xbd, 0xc9, 0x93, 0x7d, 0x02, 0xc5, 0xa1, 0x96, 0x9d, 0x30, 0xee, 0x8b, 0x09, 0x75,
+	0x75, 0xbd, 0xb5, 0xfe, 0xef, 0x59, 0x65, 0x01, 0x06, 0x85, 0xa1, 0x96, 0xbb, 0xb8, 0x62, 0xef,
+	0x83, 0x8b, 0xc2, 0x53, 0x8f, 0x03, 0x5c, 0xb2, 0xbd, 0x79, 0x31, 0x2e, 0x15, 0xf3, 0xd1, 0x6a,
+	0xdd, 0xf7, 0x4c, 0x12, 0xc6, 0x32, 0xad, 0x6d, 0xc3, 0x8a, 0xbe, 0xb6, 0x04, 0xea, 0x45, 0xad,
+	0x3f, 0xbe, 0xae, 0x3a, 0xb5, 0x04, 0x4a, 0x4b, 0x51, 0x6c, 0x04, 0x3a, 0x97, 0x4a, 0x2c, 0x06,
+	0xb4, 0x66, 0xbb, 0x00, 0xdc, 0x98, 0x24, 0xec, 0x8e, 0x8d, 0xd0, 0x7e, 0x96, 0x2a, 0xb8, 0x7d,
+	0x45, 0xe7, 0x67, 0xb9, 0x56, 0x9b, 0xa5, 0xc3, 0xf6, 0x3f, 0xef, 0x41, 0x71, 0x9e, 0x84, 0x6c,
+	0x0f, 0xc5, 0xd4, 0xfe, 0x21, 0x2e, 0xd9, 0x06, 0x5c, 0x3b, 0xe2, 0xd1, 0x58, 0x58, 0x05, 0xd2,
+	0x4d, 0xed, 0x17, 0x07, 0xf2, 0xcf, 0xb8, 0xde, 0xbd, 0x6c, 0x0d, 0x3c, 0x9a, 0x5b, 0x65, 0x8d,
+	0x2c, 0x05, 0xe7, 0xd6, 0xf8, 0x1a, 0x4a, 0x18, 0x1a, 0x25, 0xea, 0x20, 0x8c, 0x84, 0x95, 0xf3,
+	0xe3, 0xd5, 0x64, 0x9e, 0x71, 0xfd, 0x5d, 0x9a, 0xfb, 0x34, 0x36, 0xc9, 0x34, 0x00, 0x39, 0x07,
+	0x6a, 0x2f, 0x1d, 0xb8, 0xfe, 0x56, 0x9c, 0xdd, 0x84, 0xa2, 0x36, 0x2a, 0x11, 0x9d, 0x05, 0xa7,
+	0x02, 0x01, 0xdf, 0x88, 0x29, 0x3a, 0x4e, 0x8d, 0x44, 0xc2, 0x4d, 0xa8, 0x62, 0x4b, 0x6e, 0x01,
+	0xb0, 0x2a, 0xac, 0xa1, 0x1b, 0x50, 0xf2, 0xce, 0x38, 0x89, 0xec, 0xf0, 0xc2, 0x50, 0xcb, 0xf6,
+	0x74, 0x24, 0xf6, 0x93, 0x08, 0xa5, 0x92, 0x5c, 0xd3, 0xf0, 0xe6, 0x02, 0x5c, 0xa2, 0x54, 0x3d,
+	0x35, 0x8e, 0x0d, 0x0d, 0x6f, 0x2e, 0x48, 0x37, 0xe8, 0x3f, 0x2f, 0x10, 0x7a, 0x1c, 0x19, 0xb6,
+	0x69, 0x87, 0x1b, 0x4b, 0x59, 0x6b, 0x65, 0x7d, 0xc7, 0x0e, 0xf8, 0x65, 0x8f, 0xdd, 0x7f, 0xcb,
+	0x63, 0xef, 0x64, 0x78, 0xf6, 0x00, 0xd6, 0xb1, 0xe8, 0xc4, 0x3e, 0xba, 0xb0, 0x38, 0x77, 0xe5,
+	0xd4, 0x21, 0xbf, 0xd9, 0x43, 0x6e, 0x36, 0x2b, 0x2f, 0x1d, 0x60, 0x7b, 0xe1, 0x70, 0x1c, 0x91,
+	0x08, 0xb3, 0x28, 0xfb, 0x32, 0x6d, 0x21, 0x3d, 0x14, 0x1c, 0x1a, 0xe4, 0x0f, 0xaf, 0x6c, 0x12,
+	0xda, 0xa2, 0x55, 0xc0, 0xd2, 0x4e, 0xce, 0x2a, 0x0e, 0xf5, 0x1b, 0x21, 0xf6, 0x39, 0x78, 0x09,
+	0x29, 0x41, 0x54, 0x4b, 0x3b, 0xd5, 0xd5, 0xb7, 0xa4, 0x8a, 0x05, 0x36, 0xbf, 0xf6, 0x08, 0xf2,
+	0x2f, 0xb4, 0xfc, 0x02, 0xc5, 0xda, 0x86, 0xc2, 0xac, 0x33, 0xb6, 0xa7, 0x79, 0xdb, 0x95, 0xf9,
+	0xc3, 0x13, 0x6f, 0x5f, 0x4b, 0xb5, 0x7d, 0xe8, 0xa1, 0xc9, 0x7d, 0xa7, 0xf6, 0x93, 0x03, 0xc5,
+	0xf6, 0x64, 0x76, 0xc9, 0x83, 0x79, 0x27, 0xdc, 0xab, 0xd9, 0xd8, 0x03, 0x4b, 0xcd, 0xba, 0x24,
+	0x72, 0xf6, 0xdd, 0x45, 0xa6, 0x81, 0x7b, 0xed, 0xc0, 0xf5, 0x3d, 0xc1, 0x93, 0xde, 0xa0, 0x3d,
+	0xd1, 0xd6, 0x19, 0x15, 0x28, 0x19, 0x65, 0x78, 0xd4, 0x49, 0x0d, 0x94, 0x0e, 0x11, 0x10, 0xf4,
+	0x04, 0x91, 0x85, 0xb7, 0xb2, 0x4b, 0xde, 0xc2, 0x63, 0x23, 0x2e, 0x45, 0x27, 0x1e, 0x0f, 0xbb,
+	0x22, 0x21, 0x93, 0xe6, 0x02, 0x40, 0xe8, 0x5b, 0x42, 0x70, 0x36, 0x29, 0x81, 0x6e, 0xb2, 0x5e,
+	0x2d, 0x22, 0xd2, 0x46, 0x00, 0x6f, 0x8d, 0xc2, 0x61, 0x38, 0x77, 0x2c, 0x6d, 0xd8, 0x67, 0xe0,
+	0x9a, 0x89, 0xf6, 0x3d, 0xe2, 0x75, 0x67, 0xb5, 0x36, 0x8b, 0x97, 0x64, 0x80, 0x07, 0x2c, 0xbd,
+	0x53, 0xf4, 0x10, 0xd1, 0x6b, 0xe1, 0xfb, 0xf6, 0x0a, 0x86, 0xee, 0x6a, 0x86, 0xee, 0x15, 0x0c,
+	0xdd, 0xff, 0x61, 0xe8, 0xae, 0x64, 0xe8, 0xce, 0x18, 0x36, 0xc1, 0xa3, 0x8f, 0x81, 0x19, 0xc9,
+	0xad, 0xe5, 0xf1, 0x4a, 0x3f, 0x22, 0xa8, 0xf8, 0xc0, 0xa6, 0xa5, 0xd4, 0x5a, 0x8f, 0x4e, 0xff,
+	0x29, 0x67, 0x5e, 0x9d, 0x97, 0x9d, 0x93, 0xf3, 0xb2, 0xf3, 0xf7, 0x79, 0xd9, 0xf9, 0xf5, 0xa2,
+	0x9c, 0x39, 0xb9, 0x28, 0x67, 0x4e, 0x2f, 0xca, 0x99, 0x1f, 0x6a, 0x32, 0x34, 0x83, 0x71, 0xb7,
+	0xd1, 0x53, 0xc3, 0xa6, 0xfd, 0xda, 0x49, 0x7f, 0x3e, 0xd5, 0xfd, 0xc3, 0xf4, 0x13, 0xa4, 0xeb,
+	0x91, 0x3b, 0xee, 0xfd, 0x37, 0x00, 0xec, 0x5e, 0x4e, 0x8f, 0x0f, 0x09, 0x00, 0x00,
 }
 
 func (m *TxResponse) Marshal() (dAtA []byte, err error) {
@@ -1068,6 +1172,20 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasProfile) > 0 {
+		for iNdEx := len(m.GasProfile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAbci(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintAbci(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1081,6 +1199,60 @@ func (m *GasInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasProfileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Gas != 0 {
+		i = encodeVarintAbci(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintAbci(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1545,6 +1717,39 @@ func (m *GasInfo) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovAbci(uint64(m.GasUsed))
 	}
+	if len(m.GasProfile) > 0 {
+		for _, e := range m.GasProfile {
+			l = e.Size()
+			n += 1 + l + sovAbci(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasProfileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAbci(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovAbci(uint64(m.Gas))
+	}
+	if m.Count != 0 {
+		n += 1 + sovAbci(uint64(m.Count))
+	}
 	return n
 }
 
@@ -2663,6 +2868,224 @@ func (m *GasInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasProfile = append(m.GasProfile, &GasProfileEntry{})
+			if err := m.GasProfile[len(m.GasProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAbci(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAbci
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAbci
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAbci
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAbci
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAbci(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -18,4 +19,8 @@ func TestCollectionsCorrectness(t *testing.T) {
 	t.Run("ConsAddress", func(t *testing.T) {
 		colltest.TestKeyCodec(t, ConsAddressKey, ConsAddress{0x32, 0x0, 0x0, 0x3})
 	})
+
+	t.Run("LegacyDec", func(t *testing.T) {
+		colltest.TestValueCodec(t, LegacyDecValue, math.LegacyNewDecWithPrec(125, 2))
+	})
 }
@@ -40,31 +40,36 @@ type Context struct {
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
+	storeGasConfigs      map[string]storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
+	gasProfiler          *GasProfiler
+	gasProfileMsgTypeURL string
 }
 
 // Proposed rename, not done to avoid API breakage
 type Request = Context
 
 // Read-only accessors
-func (c Context) Context() context.Context                      { return c.baseCtx }
-func (c Context) MultiStore() storetypes.MultiStore             { return c.ms }
-func (c Context) BlockHeight() int64                            { return c.header.Height }
-func (c Context) BlockTime() time.Time                          { return c.header.Time }
-func (c Context) ChainID() string                               { return c.chainID }
-func (c Context) TxBytes() []byte                               { return c.txBytes }
-func (c Context) Logger() log.Logger                            { return c.logger }
-func (c Context) VoteInfos() []abci.VoteInfo                    { return c.voteInfo }
-func (c Context) GasMeter() storetypes.GasMeter                 { return c.gasMeter }
-func (c Context) BlockGasMeter() storetypes.GasMeter            { return c.blockGasMeter }
-func (c Context) IsCheckTx() bool                               { return c.checkTx }
-func (c Context) IsReCheckTx() bool                             { return c.recheckTx }
-func (c Context) MinGasPrices() DecCoins                        { return c.minGasPrice }
-func (c Context) EventManager() EventManagerI                   { return c.eventManager }
-func (c Context) Priority() int64                               { return c.priority }
-func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
-func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
-func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
+func (c Context) Context() context.Context                         { return c.baseCtx }
+func (c Context) MultiStore() storetypes.MultiStore                { return c.ms }
+func (c Context) BlockHeight() int64                               { return c.header.Height }
+func (c Context) BlockTime() time.Time                             { return c.header.Time }
+func (c Context) ChainID() string                                  { return c.chainID }
+func (c Context) TxBytes() []byte                                  { return c.txBytes }
+func (c Context) Logger() log.Logger                               { return c.logger }
+func (c Context) VoteInfos() []abci.VoteInfo                       { return c.voteInfo }
+func (c Context) GasMeter() storetypes.GasMeter                    { return c.gasMeter }
+func (c Context) BlockGasMeter() storetypes.GasMeter               { return c.blockGasMeter }
+func (c Context) IsCheckTx() bool                                  { return c.checkTx }
+func (c Context) IsReCheckTx() bool                                { return c.recheckTx }
+func (c Context) MinGasPrices() DecCoins                           { return c.minGasPrice }
+func (c Context) EventManager() EventManagerI                      { return c.eventManager }
+func (c Context) Priority() int64                                  { return c.priority }
+func (c Context) KVGasConfig() storetypes.GasConfig                { return c.kvGasConfig }
+func (c Context) TransientKVGasConfig() storetypes.GasConfig       { return c.transientKVGasConfig }
+func (c Context) StoreGasConfigs() map[string]storetypes.GasConfig { return c.storeGasConfigs }
+func (c Context) StreamingManager() storetypes.StreamingManager    { return c.streamingManager }
+func (c Context) GasProfiler() *GasProfiler                        { return c.gasProfiler }
 
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
@@ -209,6 +214,23 @@ func (c Context) WithKVGasConfig(gasConfig storetypes.GasConfig) Context {
 	return c
 }
 
+// WithGasProfiler returns a Context recording the gas consumed by the KV store operations in the
+// given profiler, as consumed by the message with the given type URL, empty outside of messages.
+func (c Context) WithGasProfiler(profiler *GasProfiler, msgTypeURL string) Context {
+	c.gasProfiler = profiler
+	c.gasProfileMsgTypeURL = msgTypeURL
+	return c
+}
+
+// WithStoreGasConfigs returns a Context with updated gas configurations of the
+// stores, by store key name. They take precedence over the default KVStore and
+// transient KVStore gas configurations, but not over the ones set with
+// WithKVGasConfig and WithTransientKVGasConfig.
+func (c Context) WithStoreGasConfigs(gasConfigs map[string]storetypes.GasConfig) Context {
+	c.storeGasConfigs = gasConfigs
+	return c
+}
+
 // WithTransientKVGasConfig returns a Context with an updated gas configuration for
 // the transient KVStore
 func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Context {
@@ -286,12 +308,39 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.storeGasConfig(key, c.kvGasConfig, storetypes.KVGasConfig()))
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.storeGasMeter(key), c.storeGasConfig(key, c.transientKVGasConfig, storetypes.TransientGasConfig()))
+}
+
+// storeGasConfig returns the gas configuration of the store with the given key if it
+// has one and ctxConfig, the store type configuration of the context, is still the
+// default one. Otherwise it returns ctxConfig.
+func (c Context) storeGasConfig(key storetypes.StoreKey, ctxConfig, defaultConfig storetypes.GasConfig) storetypes.GasConfig {
+	if ctxConfig != defaultConfig {
+		return ctxConfig
+	}
+	if gasConfig, ok := c.storeGasConfigs[key.Name()]; ok {
+		return gasConfig
+	}
+	return ctxConfig
+}
+
+// storeGasMeter returns the gas meter of the store with the given key, which records the gas
+// consumed in the gas profiler if any.
+func (c Context) storeGasMeter(key storetypes.StoreKey) storetypes.GasMeter {
+	if c.gasProfiler == nil {
+		return c.gasMeter
+	}
+	return profilingGasMeter{
+		GasMeter:   c.gasMeter,
+		profiler:   c.gasProfiler,
+		storeKey:   key.Name(),
+		msgTypeURL: c.gasProfileMsgTypeURL,
+	}
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
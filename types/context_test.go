@@ -59,6 +59,72 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Len(ctx.EventManager().Events(), 2)
 }
 
+func (s *contextTestSuite) TestGasProfiler() {
+	key := storetypes.NewKVStoreKey(s.T().Name() + "_TestGasProfiler")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name())).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	s.Require().Nil(ctx.GasProfiler())
+
+	profiler := types.NewGasProfiler()
+	ctx = ctx.WithGasProfiler(profiler, "")
+	ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+
+	msgCtx := ctx.WithGasProfiler(profiler, "/test.Msg")
+	msgCtx.KVStore(key).Get([]byte("key"))
+	msgCtx.KVStore(key).Has([]byte("key"))
+
+	cfg := storetypes.KVGasConfig()
+	profile := profiler.Profile()
+	s.Require().Equal([]*types.GasProfileEntry{
+		{StoreKey: key.Name(), Operation: types.GasOperationWrite, Gas: cfg.WriteCostFlat + 8*cfg.WriteCostPerByte, Count: 3},
+		{StoreKey: key.Name(), Operation: types.GasOperationHas, MsgTypeUrl: "/test.Msg", Gas: cfg.HasCost, Count: 1},
+		{StoreKey: key.Name(), Operation: types.GasOperationRead, MsgTypeUrl: "/test.Msg", Gas: cfg.ReadCostFlat + 8*cfg.ReadCostPerByte, Count: 3},
+	}, profile)
+
+	var total uint64
+	for _, entry := range profile {
+		total += entry.Gas
+	}
+	s.Require().Equal(ctx.GasMeter().GasConsumed(), total)
+}
+
+func (s *contextTestSuite) TestStoreGasConfigs() {
+	key := storetypes.NewKVStoreKey(s.T().Name() + "_TestStoreGasConfigs")
+	tkey := storetypes.NewTransientStoreKey("transient_" + s.T().Name())
+	ctx := testutil.DefaultContext(key, tkey)
+	s.Require().Nil(ctx.StoreGasConfigs())
+
+	gasConfig := storetypes.KVGasConfig()
+	gasConfig.HasCost = 7
+	ctx = ctx.WithStoreGasConfigs(map[string]storetypes.GasConfig{key.Name(): gasConfig}).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Has([]byte("key"))
+	s.Require().Equal(uint64(7), ctx.GasMeter().GasConsumed())
+
+	// stores without their own gas config keep the default one
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.TransientStore(tkey).Has([]byte("key"))
+	s.Require().Equal(storetypes.TransientGasConfig().HasCost, ctx.GasMeter().GasConsumed())
+
+	// an explicit KVStore gas config takes precedence over the store gas configs
+	kvGasConfig := storetypes.KVGasConfig()
+	kvGasConfig.HasCost = 11
+	ctx = ctx.WithKVGasConfig(kvGasConfig).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Has([]byte("key"))
+	s.Require().Equal(uint64(11), ctx.GasMeter().GasConsumed())
+
+	// and so does an explicit transient KVStore gas config
+	transientGasConfig := storetypes.TransientGasConfig()
+	transientGasConfig.HasCost = 13
+	ctx = ctx.WithStoreGasConfigs(map[string]storetypes.GasConfig{tkey.Name(): gasConfig}).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.TransientStore(tkey).Has([]byte("key"))
+	s.Require().Equal(uint64(7), ctx.GasMeter().GasConsumed())
+	ctx = ctx.WithTransientKVGasConfig(transientGasConfig).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.TransientStore(tkey).Has([]byte("key"))
+	s.Require().Equal(uint64(13), ctx.GasMeter().GasConsumed())
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := storetypes.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
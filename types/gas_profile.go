@@ -0,0 +1,103 @@
+package types
+
+import (
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Operations of the gas profile entries.
+const (
+	GasOperationRead   = "read"
+	GasOperationWrite  = "write"
+	GasOperationHas    = "has"
+	GasOperationDelete = "delete"
+	GasOperationIter   = "iter"
+)
+
+// gasOperations maps the descriptors of the gas charged by the gaskv stores to their operation.
+var gasOperations = map[string]string{
+	storetypes.GasReadCostFlatDesc:     GasOperationRead,
+	storetypes.GasReadPerByteDesc:      GasOperationRead,
+	storetypes.GasWriteCostFlatDesc:    GasOperationWrite,
+	storetypes.GasWritePerByteDesc:     GasOperationWrite,
+	storetypes.GasHasDesc:              GasOperationHas,
+	storetypes.GasDeleteDesc:           GasOperationDelete,
+	storetypes.GasIterNextCostFlatDesc: GasOperationIter,
+	storetypes.GasValuePerByteDesc:     GasOperationIter,
+}
+
+type gasProfileKey struct {
+	storeKey, operation, msgTypeURL string
+}
+
+// GasProfiler breaks down the gas consumed by the KV store operations of a transaction by store,
+// operation and message type. It's safe for concurrent use.
+type GasProfiler struct {
+	mtx     sync.Mutex
+	entries map[gasProfileKey]*GasProfileEntry
+}
+
+// NewGasProfiler returns an empty GasProfiler.
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{entries: make(map[gasProfileKey]*GasProfileEntry)}
+}
+
+// Record records gas consumed by an operation on the store with the given key, while executing a
+// message with the given type URL.
+func (p *GasProfiler) Record(storeKey, operation, msgTypeURL string, gas uint64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	key := gasProfileKey{storeKey: storeKey, operation: operation, msgTypeURL: msgTypeURL}
+	entry, ok := p.entries[key]
+	if !ok {
+		entry = &GasProfileEntry{StoreKey: storeKey, Operation: operation, MsgTypeUrl: msgTypeURL}
+		p.entries[key] = entry
+	}
+	entry.Gas += gas
+	entry.Count++
+}
+
+// Profile returns the entries of the profile, sorted by message type URL, store and operation.
+func (p *GasProfiler) Profile() []*GasProfileEntry {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	profile := make([]*GasProfileEntry, 0, len(p.entries))
+	for _, entry := range p.entries {
+		e := *entry
+		profile = append(profile, &e)
+	}
+	sort.Slice(profile, func(i, j int) bool {
+		a, b := profile[i], profile[j]
+		if a.MsgTypeUrl != b.MsgTypeUrl {
+			return a.MsgTypeUrl < b.MsgTypeUrl
+		}
+		if a.StoreKey != b.StoreKey {
+			return a.StoreKey < b.StoreKey
+		}
+		return a.Operation < b.Operation
+	})
+	return profile
+}
+
+// profilingGasMeter records the gas charged by a gaskv store in a GasProfiler.
+type profilingGasMeter struct {
+	storetypes.GasMeter
+	profiler   *GasProfiler
+	storeKey   string
+	msgTypeURL string
+}
+
+// ConsumeGas implements the GasMeter interface, the gas is only recorded once consumed.
+func (m profilingGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+
+	operation, ok := gasOperations[descriptor]
+	if !ok {
+		operation = descriptor
+	}
+	m.profiler.Record(m.storeKey, operation, m.msgTypeURL, amount)
+}
@@ -39,6 +39,23 @@ type (
 		//   (sequence number) when evicting transactions.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
+
+		// MaxTxPerSender sets the maximum number of transactions a single sender
+		// can have in the mempool. If MaxTxPerSender <= 0, there is no cap.
+		// Replacing an existing transaction of the sender is always allowed.
+		MaxTxPerSender int
+
+		// MaxBytes sets the maximum total size, in bytes, of the transactions
+		// stored in the mempool. The size of a transaction is read from the
+		// sdk.Context TxBytes on Insert. If MaxBytes <= 0, there is no cap.
+		MaxBytes int64
+
+		// TTLNumBlocks sets the number of blocks a transaction can stay in the
+		// mempool. A transaction inserted at height h is removed on the first
+		// Insert or Select at a height greater than h + TTLNumBlocks, along with
+		// the transactions of the same sender with a greater nonce. If
+		// TTLNumBlocks <= 0, transactions never expire.
+		TTLNumBlocks int64
 	}
 
 	// PriorityNonceMempool is a mempool implementation that stores txs
@@ -53,6 +70,8 @@ type (
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
+		expirations    *skiplist.SkipList
+		totalBytes     int64
 		cfg            PriorityNonceMempoolConfig[C]
 	}
 
@@ -93,6 +112,10 @@ type (
 		// weight is the transaction's weight, used as a tiebreaker for transactions
 		// with the same priority
 		weight C
+		// bytes is the size of the transaction in bytes
+		bytes int64
+		// height is the block height at which the transaction was inserted
+		height int64
 		// senderElement is a pointer to the transaction's element in the sender index
 		senderElement *skiplist.Element
 	}
@@ -152,6 +175,29 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 	})
 }
 
+// expirationComparable is a comparator for txKeys that compares height, then
+// sender, then nonce, ordering the transactions from the oldest one.
+//
+// Note, expirationComparable is used as the comparator in the expiration index.
+func expirationComparable[C comparable]() skiplist.Comparable {
+	return skiplist.GreaterThanFunc(func(a, b any) int {
+		keyA := a.(txMeta[C])
+		keyB := b.(txMeta[C])
+
+		res := skiplist.Int64.Compare(keyA.height, keyB.height)
+		if res != 0 {
+			return res
+		}
+
+		res = skiplist.String.Compare(keyA.sender, keyB.sender)
+		if res != 0 {
+			return res
+		}
+
+		return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+	})
+}
+
 // NewPriorityMempool returns the SDK's default mempool implementation which
 // returns txs in a partial order by 2 dimensions; priority, and sender-nonce.
 func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *PriorityNonceMempool[C] {
@@ -160,6 +206,7 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		priorityCounts: make(map[C]int),
 		senderIndices:  make(map[string]*skiplist.SkipList),
 		scores:         make(map[txMeta[C]]txMeta[C]),
+		expirations:    skiplist.New(expirationComparable[C]()),
 		cfg:            cfg,
 	}
 
@@ -193,10 +240,16 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
+//
+// When the mempool is full, either by MaxTx or by MaxBytes, the lowest priority
+// transactions are evicted to make room for tx, as long as they have a strictly
+// lower priority than tx. Evicting a transaction also evicts the transactions of
+// the same sender with a greater nonce, as they could not be included in a block
+// anymore, so a transaction is only evicted if those have a strictly lower
+// priority than tx as well. If not enough transactions can be evicted, ErrMempoolTxMaxCapacity is
+// returned and the mempool is left untouched.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
-	if mp.cfg.MaxTx > 0 && mp.CountTx() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -213,9 +266,49 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
 	nonce := sig.Sequence
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	if mp.cfg.MaxBytes > 0 {
+		key.bytes = int64(len(sdk.UnwrapSDKContext(ctx).TxBytes()))
+	}
+	if mp.cfg.TTLNumBlocks > 0 {
+		key.height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+		mp.removeExpired(key.height)
+	}
 
-	senderIndex, ok := mp.senderIndices[sender]
-	if !ok {
+	senderIndex, senderExists := mp.senderIndices[sender]
+
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	oldScore, txExists := mp.scores[sk]
+	if txExists {
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
+			return fmt.Errorf(
+				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
+				oldScore.priority,
+				priority,
+				senderIndex.Get(key).Value.(sdk.Tx),
+				tx,
+			)
+		}
+	} else if mp.cfg.MaxTxPerSender > 0 && senderExists && senderIndex.Len() >= mp.cfg.MaxTxPerSender {
+		return ErrMempoolSenderTxMaxCapacity
+	}
+
+	// a replacement does not change the number of transactions in the mempool,
+	// only its size.
+	count, size := mp.CountTx()+1, mp.totalBytes+key.bytes
+	if txExists {
+		count, size = count-1, size-oldScore.bytes
+	}
+	if mp.exceedsCapacity(count, size) {
+		evicted, ok := mp.evictionCandidates(key, count, size)
+		if !ok {
+			return ErrMempoolTxMaxCapacity
+		}
+		for _, k := range evicted {
+			mp.remove(k)
+		}
+	}
+
+	if !senderExists {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
 			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
 		}))
@@ -231,18 +324,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
-			return fmt.Errorf(
-				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
-				oldScore.priority,
-				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
-				tx,
-			)
-		}
-
+	if txExists {
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
 			sender:   sender,
@@ -250,20 +332,93 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.totalBytes -= oldScore.bytes
+		mp.expirations.Remove(txMeta[C]{nonce: nonce, sender: sender, height: oldScore.height})
 	}
 
 	mp.priorityCounts[priority]++
+	mp.totalBytes += key.bytes
 
 	// Since senderIndex is scored by nonce, a changed priority will overwrite the
 	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
-	mp.scores[sk] = txMeta[C]{priority: priority}
+	mp.scores[sk] = txMeta[C]{priority: priority, bytes: key.bytes, height: key.height}
 	mp.priorityIndex.Set(key, tx)
+	if mp.cfg.TTLNumBlocks > 0 {
+		mp.expirations.Set(txMeta[C]{nonce: nonce, sender: sender, height: key.height}, nil)
+	}
 
 	return nil
 }
 
+// exceedsCapacity returns true if a mempool holding count transactions of a
+// total of size bytes would exceed the MaxTx or MaxBytes caps.
+func (mp *PriorityNonceMempool[C]) exceedsCapacity(count int, size int64) bool {
+	return (mp.cfg.MaxTx > 0 && count > mp.cfg.MaxTx) ||
+		(mp.cfg.MaxBytes > 0 && size > mp.cfg.MaxBytes)
+}
+
+// evictionCandidates returns the score keys of the transactions to evict, from
+// the lowest priority one, so that the mempool fits its caps once key is
+// inserted. It returns false if no such set of transactions exists.
+func (mp *PriorityNonceMempool[C]) evictionCandidates(key txMeta[C], count int, size int64) ([]txMeta[C], bool) {
+	var evicted []txMeta[C]
+	seen := make(map[txMeta[C]]struct{})
+	for node := mp.priorityIndex.Back(); mp.exceedsCapacity(count, size); node = node.Prev() {
+		if node == nil {
+			return nil, false
+		}
+
+		k := node.Key().(txMeta[C])
+		if _, ok := seen[txMeta[C]{nonce: k.nonce, sender: k.sender}]; ok {
+			continue
+		}
+		// the priority index is ordered by priority, so all the remaining
+		// transactions have a priority greater than or equal to key's.
+		if mp.cfg.TxPriority.Compare(k.priority, key.priority) >= 0 {
+			return nil, false
+		}
+		if k.sender == key.sender {
+			// evicting a previous transaction of the sender would make key
+			// impossible to include in a block.
+			if k.nonce < key.nonce {
+				return nil, false
+			}
+			// key replaces this transaction.
+			if k.nonce == key.nonce {
+				continue
+			}
+		}
+
+		// evicting k also evicts the following transactions of its sender, so
+		// they must have a lower priority than key as well.
+		var senderEvicted []txMeta[C]
+		for cursor := k.senderElement; cursor != nil; cursor = cursor.Next() {
+			ck := cursor.Key().(txMeta[C])
+			sk := txMeta[C]{nonce: ck.nonce, sender: ck.sender}
+			if mp.cfg.TxPriority.Compare(mp.scores[sk].priority, key.priority) >= 0 {
+				senderEvicted = nil
+				break
+			}
+			senderEvicted = append(senderEvicted, sk)
+		}
+
+		for _, sk := range senderEvicted {
+			if _, ok := seen[sk]; ok {
+				continue
+			}
+
+			seen[sk] = struct{}{}
+			evicted = append(evicted, sk)
+			count--
+			size -= mp.scores[sk].bytes
+		}
+	}
+
+	return evicted, true
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -333,11 +488,17 @@ func (i *PriorityNonceIterator[C]) Tx() sdk.Tx {
 
 // Select returns a set of transactions from the mempool, ordered by priority
 // and sender-nonce in O(n) time. The passed in list of transactions are ignored.
-// This is a readonly operation, the mempool is not modified.
+// This is a readonly operation, the mempool is not modified, except when
+// TTLNumBlocks is set: the transactions which expired at the block height of
+// ctx, and the following transactions of their senders, are then removed first.
 //
 // The maxBytes parameter defines the maximum number of bytes of transactions to
 // return.
-func (mp *PriorityNonceMempool[C]) Select(_ context.Context, _ [][]byte) Iterator {
+func (mp *PriorityNonceMempool[C]) Select(ctx context.Context, _ [][]byte) Iterator {
+	if mp.cfg.TTLNumBlocks > 0 {
+		mp.removeExpired(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	}
+
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
@@ -425,23 +586,53 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	nonce := sig.Sequence
 
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
-	score, ok := mp.scores[scoreKey]
-	if !ok {
+	if _, ok := mp.scores[scoreKey]; !ok {
 		return ErrTxNotFound
 	}
-	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
 
-	senderTxs, ok := mp.senderIndices[sender]
-	if !ok {
+	if _, ok := mp.senderIndices[sender]; !ok {
 		return fmt.Errorf("sender %s not found", sender)
 	}
 
+	mp.remove(scoreKey)
+	return nil
+}
+
+// remove removes the transaction identified by the given score key, which must
+// exist in the mempool.
+func (mp *PriorityNonceMempool[C]) remove(scoreKey txMeta[C]) {
+	score := mp.scores[scoreKey]
+	tk := txMeta[C]{nonce: scoreKey.nonce, priority: score.priority, sender: scoreKey.sender, weight: score.weight}
+
 	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
+	mp.senderIndices[scoreKey.sender].Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.totalBytes -= score.bytes
+	mp.expirations.Remove(txMeta[C]{nonce: scoreKey.nonce, sender: scoreKey.sender, height: score.height})
+}
 
-	return nil
+// removeExpired removes the transactions which have been in the mempool for
+// more than TTLNumBlocks blocks at the given height, from the oldest one.
+// Removing a transaction also removes the transactions of the same sender with
+// a greater nonce, as they could not be included in a block anymore.
+func (mp *PriorityNonceMempool[C]) removeExpired(height int64) {
+	for front := mp.expirations.Front(); front != nil; front = mp.expirations.Front() {
+		k := front.Key().(txMeta[C])
+		if height-k.height <= mp.cfg.TTLNumBlocks {
+			return
+		}
+
+		var expired []txMeta[C]
+		for cursor := mp.senderIndices[k.sender].Get(k); cursor != nil; cursor = cursor.Next() {
+			ck := cursor.Key().(txMeta[C])
+			expired = append(expired, txMeta[C]{nonce: ck.nonce, sender: ck.sender})
+		}
+
+		for _, sk := range expired {
+			mp.remove(sk)
+		}
+	}
 }
 
 func IsEmpty[C comparable](mempool Mempool) error {
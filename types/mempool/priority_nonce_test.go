@@ -658,24 +658,37 @@ func TestNextSenderTx_TxLimit(t *testing.T) {
 		require.Equal(t, i+1, mp.CountTx())
 	}
 
-	// limit: 3
+	// limit: 3, the lowest priority txs are evicted when they have a strictly
+	// lower priority than the inserted tx and do not precede it.
 	mp = mempool.NewPriorityMempool(
 		mempool.PriorityNonceMempoolConfig[int64]{
 			TxPriority: mempool.NewDefaultTxPriority(),
 			MaxTx:      3,
 		},
 	)
+	rejected := map[int]bool{
+		4: true, // evicting sa nonce 1 would leave the tx unexecutable
+		5: true, // lower priority than every tx
+		6: true, // same priority as the lowest tx
+		9: true, // evicting sb nonce 1 would leave the tx unexecutable
+	}
 	for i, tx := range txs {
 		c := ctx.WithPriority(tx.priority)
 		err := mp.Insert(c, tx)
-		if i < 3 {
+		switch {
+		case i < 3:
 			require.NoError(t, err)
 			require.Equal(t, i+1, mp.CountTx())
-		} else {
+		case rejected[i]:
 			require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
 			require.Equal(t, 3, mp.CountTx())
+		default:
+			require.NoError(t, err)
+			require.Equal(t, 3, mp.CountTx())
 		}
 	}
+	// sb nonce 1 is kept over sb nonce 4 as evicting it would also evict sb nonce 2
+	require.Equal(t, []sdk.Tx{txs[8], txs[1], txs[3]}, fetchTxs(mp.Select(ctx, nil), 1000))
 
 	// disabled
 	mp = mempool.NewPriorityMempool(
@@ -748,3 +761,176 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_MaxTxPerSender(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:     mempool.NewDefaultTxPriority(),
+			MaxTxPerSender: 2,
+		},
+	)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 2, address: sa}))
+	err := mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolSenderTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+
+	// replacing a tx of the sender is allowed
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{priority: 20, nonce: 2, address: sa}))
+	require.Equal(t, 2, mp.CountTx())
+
+	// other senders are not affected
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// the sender quota is freed on removal
+	require.NoError(t, mp.Remove(testTx{nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_MaxBytes(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			MaxBytes:   100,
+		},
+	)
+	insert := func(tx testTx, size int) error {
+		return mp.Insert(ctx.WithPriority(tx.priority).WithTxBytes(make([]byte, size)), tx)
+	}
+
+	txs := []testTx{
+		{priority: 10, nonce: 1, address: sa},
+		{priority: 20, nonce: 1, address: sb},
+		{priority: 30, nonce: 1, address: sc},
+	}
+	require.NoError(t, insert(txs[0], 40))
+	require.NoError(t, insert(txs[1], 40))
+
+	// a tx bigger than the mempool can never fit
+	require.ErrorIs(t, insert(txs[2], 101), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+
+	// a lower priority tx cannot evict higher priority txs
+	require.ErrorIs(t, insert(testTx{priority: 5, nonce: 1, address: sc}, 40), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 2, mp.CountTx())
+
+	// the lowest priority tx is evicted to make room
+	require.NoError(t, insert(txs[2], 40))
+	require.Equal(t, []sdk.Tx{txs[2], txs[1]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	// replacing a tx accounts for the size of the replaced tx
+	require.NoError(t, insert(txs[1], 60))
+	require.Equal(t, 2, mp.CountTx())
+
+	// the size of removed txs is freed
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, insert(txs[0], 60))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_EvictSenderTxs(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			MaxTx:      3,
+		},
+	)
+
+	txs := []testTx{
+		{priority: 10, nonce: 1, address: sa},
+		{priority: 15, nonce: 2, address: sa},
+		{priority: 12, nonce: 3, address: sa},
+		{priority: 20, nonce: 1, address: sb},
+	}
+	for _, tx := range txs[:3] {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// evicting sa nonce 1 evicts the following txs of sa as well
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[3].priority), txs[3]))
+	require.Equal(t, []sdk.Tx{txs[3]}, fetchTxs(mp.Select(ctx, nil), 1000))
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+}
+
+func TestPriorityNonceMempool_TTLNumBlocks(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:   mempool.NewDefaultTxPriority(),
+			TTLNumBlocks: 2,
+		},
+	)
+
+	txA := testTx{priority: 10, nonce: 1, address: sa}
+	txB := testTx{priority: 20, nonce: 1, address: sb}
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10).WithPriority(txA.priority), txA))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(11).WithPriority(txB.priority), txB))
+
+	require.Equal(t, []sdk.Tx{txB, txA}, fetchTxs(mp.Select(ctx.WithBlockHeight(12), nil), 1000))
+	require.Equal(t, []sdk.Tx{txB}, fetchTxs(mp.Select(ctx.WithBlockHeight(13), nil), 1000))
+	require.Equal(t, 1, mp.CountTx())
+
+	require.Nil(t, mp.Select(ctx.WithBlockHeight(14), nil))
+	require.NoError(t, mempool.IsEmpty[int64](mp))
+}
+
+func TestPriorityNonceMempool_TTLNumBlocksNonceGap(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:   mempool.NewDefaultTxPriority(),
+			TTLNumBlocks: 2,
+		},
+	)
+
+	txs := []testTx{
+		{priority: 10, nonce: 1, address: sa},
+		{priority: 10, nonce: 2, address: sa},
+		{priority: 10, nonce: 3, address: sa},
+		{priority: 20, nonce: 1, address: sb},
+	}
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10).WithPriority(txs[0].priority), txs[0]))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12).WithPriority(txs[1].priority), txs[1]))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12).WithPriority(txs[2].priority), txs[2]))
+	require.Equal(t, 3, mp.CountTx())
+
+	// expiring sa nonce 1 on insert also removes the following txs of sa, which
+	// could not be included in a block anymore.
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(13).WithPriority(txs[3].priority), txs[3]))
+	require.Equal(t, 1, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{txs[3]}, fetchTxs(mp.Select(ctx.WithBlockHeight(13), nil), 1000))
+
+	// the txs of sa can be inserted again from its next nonce
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(13).WithPriority(txs[0].priority), txs[0]))
+	require.Equal(t, []sdk.Tx{txs[3], txs[0]}, fetchTxs(mp.Select(ctx.WithBlockHeight(14), nil), 1000))
+	require.Nil(t, mp.Select(ctx.WithBlockHeight(16), nil))
+	require.NoError(t, mempool.IsEmpty[int64](mp))
+}
@@ -37,6 +37,9 @@ type TxInputs struct {
 	// TxBankKeeper is the expected bank keeper to be passed to Textual
 	TxBankKeeper   BankKeeper
 	FeeGrantKeeper ante.FeegrantKeeper `optional:"true"`
+	// TxFeeChecker is the fee checker of the DeductFeeDecorator, e.g. the x/feemarket
+	// one. The validator minimum gas prices are used when it is not provided.
+	TxFeeChecker ante.TxFeeChecker `optional:"true"`
 
 	CustomSignModeHandlers func() []signing.SignModeHandler `optional:"true"`
 }
@@ -112,6 +115,7 @@ func newAnteHandler(txConfig client.TxConfig, in TxInputs) (sdk.AnteHandler, err
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  in.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			TxFeeChecker:    in.TxFeeChecker,
 		},
 	)
 	if err != nil {
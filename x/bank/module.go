@@ -7,6 +7,7 @@ import (
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -146,6 +147,16 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, accountKeeper types.Acc
 // Name returns the bank module's name.
 func (AppModule) Name() string { return types.ModuleName }
 
+// CollectionsSchema returns the collections schema of the bank module, exposed
+// through the runtime collections query service.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	k, ok := am.keeper.(keeper.BaseKeeper)
+	if !ok {
+		return collections.Schema{}
+	}
+	return k.Schema
+}
+
 // RegisterInvariants registers the bank module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
@@ -0,0 +1,60 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CircuitBreaker is an interface that defines the methods for a circuit breaker.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) bool
+}
+
+// nestedMsgs is implemented by Msgs wrapping other Msgs, such as
+// x/authz MsgExec. Wrapped Msgs are subject to the circuit breaker as well.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// CircuitBreakerDecorator is an AnteDecorator that checks if the transaction
+// type is allowed to enter the mempool or be executed.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (cbd CircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if !cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg)) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tx type %s not allowed", sdk.MsgTypeURL(msg))
+		}
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			innerMsgs, err := nested.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := cbd.checkMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package ante_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+)
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (m mockCircuitBreaker) IsAllowed(_ sdk.Context, typeURL string) bool {
+	return !m.disabled[typeURL]
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr)
+
+	breaker := mockCircuitBreaker{disabled: map[string]bool{}}
+	decorator := ante.NewCircuitBreakerDecorator(breaker)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}}, false, next)
+	require.NoError(t, err)
+
+	breaker.disabled[sdk.MsgTypeURL(msg)] = true
+
+	_, err = decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}}, false, next)
+	require.ErrorContains(t, err, "not allowed")
+}
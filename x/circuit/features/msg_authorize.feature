@@ -12,6 +12,15 @@ Feature: MsgAuthorizeCircuitBreaker
         { "level": "LEVEL_ALL_MSGS" }
         """
       Then expect success
+      And expect that "acct2" has permission "LEVEL_ALL_MSGS"
+
+    Example: granter is the module authority
+      When "authority" attempts to grant "acct2" the permissions
+        """
+        { "level": "LEVEL_SUPER_ADMIN" }
+        """
+      Then expect success
+      And expect that "acct2" has permission "LEVEL_SUPER_ADMIN"
 
     Example: granter has no permissions
       Given "acct1" has no permissions
@@ -32,15 +41,16 @@ Feature: MsgAuthorizeCircuitBreaker
   Rule: limit_msg_types must be used with LEVEL_SOME_MSGS
 
     Example: granting LEVEL_SOME_MSGS with limit_msg_types
-      Given "acct1" has permission "LEVEL_ALL_MSGS"
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       When "acct1" attempts to grant "acct2" the permissions
         """
         {
-         "level": "LEVEL_SOME_MSGS"
-         "limit_msg_types": "cosmos.bank.v1beta1.MsgSend"
+          "level": "LEVEL_SOME_MSGS",
+          "limit_msg_types": ["/cosmos.bank.v1beta1.MsgSend"]
         }
         """
       Then expect success
+      And expect that "acct2" has permission "LEVEL_SOME_MSGS"
 
     Example: granting LEVEL_SOME_MSGS without limit_msg_types
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
@@ -56,18 +66,18 @@ Feature: MsgAuthorizeCircuitBreaker
         """
         {
           "level": "LEVEL_ALL_MSGS",
-          "limit_msg_types": "cosmos.bank.v1beta1.MsgSend"
+          "limit_msg_types": ["/cosmos.bank.v1beta1.MsgSend"]
         }
         """
       Then expect an "invalid request" error
 
     Example: attempting to revoke with limit_msg_types
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
-      When "acct1" attempts to revoke "acct2" the permissions
+      When "acct1" attempts to grant "acct2" the permissions
         """
         {
           "level": "LEVEL_NONE_UNSPECIFIED",
-          "limit_msg_types": "cosmos.bank.v1beta1.MsgSend"
+          "limit_msg_types": ["/cosmos.bank.v1beta1.MsgSend"]
         }
         """
       Then expect an "invalid request" error
@@ -77,11 +87,9 @@ Feature: MsgAuthorizeCircuitBreaker
     Example: revoking permissions
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       And "acct2" has permission "LEVEL_ALL_MSGS"
-      When "acct1" attempts to revoke "acct2" the permissions
+      When "acct1" attempts to grant "acct2" the permissions
         """
-        {
-          "level": "LEVEL_NONE_UNSPECIFIED",
-        }
+        { "level": "LEVEL_NONE_UNSPECIFIED" }
         """
-      Then expect sucesss
+      Then expect success
       And expect that "acct2" has no permissions
@@ -1,65 +1,83 @@
 Feature: MsgResetCircuitBreaker
-	- Circuit breaker can be reset:
-	- when the permissions are valid
+  Circuit breaker can be reset:
+  - when the message has been disabled
+  - when the permissions are valid
 
   Rule: caller must have a permission to reset the circuit
 
-    Example: caller attempts to reset a disabled message
+    Example: super admin resets a disabled message
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
-      When "acct1" attempts to enable a disabled message
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      When "acct1" attempts to reset the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is enabled
 
     Example: caller has no permissions
       Given "acct1" has no permissions
-      When "acct1" attempts to reset a disabled message
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      When "acct1" attempts to reset the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect an "unauthorized" error
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is disabled
 
-    Example: caller attempts to reset a disabled message
+    Example: caller with all msg's permissions resets a disabled message
       Given "acct1" has permission "LEVEL_ALL_MSGS"
-      When "acct1" attempts to reset a disabled message
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      When "acct1" attempts to reset the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is enabled
 
-    Example: caller attempts to reset a message they have permission to trip
-      Given "acct1" has permission to trip circuit breaker for "cosmos.bank.v1beta1.MsgSend"
-      When "acct1" attempts to reset a disabled message
+    Example: caller resets a message they have permission to trip
+      Given "acct1" has permission to trip the circuit breaker for
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        ["/cosmos.bank.v1beta1.MsgSend"]
+        """
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      When "acct1" attempts to reset the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
 
-    Example: caller attempts to reset a message they don't have permission to trip
-      Given "acct1" has permission to trip circuit breaker for "cosmos.bank.v1beta1.MsgSend"
-      When "acct1" attempts to reset a disabled message
+    Example: caller resets a message they don't have permission to trip
+      Given "acct1" has permission to trip the circuit breaker for
+        """
+        ["/cosmos.bank.v1beta1.MsgSend"]
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+      And "/cosmos.bank.v1beta1.MsgMultiSend" is disabled
+      When "acct1" attempts to reset the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgMultiSend"] }
+        """
+      Then expect an "unauthorized" error
+
+    Example: caller resets all the messages they have permission to trip
+      Given "acct1" has permission to trip the circuit breaker for
+        """
+        ["/cosmos.bank.v1beta1.MsgSend"]
+        """
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      And "/cosmos.bank.v1beta1.MsgMultiSend" is disabled
+      When "acct1" attempts to reset the circuit breaker
+        """
+        {}
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is enabled
+      And expect that "/cosmos.bank.v1beta1.MsgMultiSend" is disabled
 
-    Example: caller attempts to reset a message that has been tripped
-      Given "acct1" has permission "LEVEL_SUPER_ADMIN" & "cosmos.bank.v1beta1.MultiSend" has been enabled
-      When "acct1" attempts to reset a disabled message
+    Example: caller attempts to reset a message that is not disabled
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
+      When "acct1" attempts to reset the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgMultiSend"] }
         """
-      Then expect an "msg enabled" error
+      Then expect an "msg not disabled" error
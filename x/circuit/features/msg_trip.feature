@@ -1,76 +1,108 @@
 Feature: MsgTripCircuitBreaker
-	Circuit breaker can disable message execution:
-	- when the caller trips the circuitbreaker for a message(s)
-	- when the caller has the correct permissions
+  Circuit breaker can disable message execution:
+  - when the caller trips the circuit breaker for a message(s)
+  - when the caller has the correct permissions
 
   Rule: a user must have permission to trip the circuit breaker for a message(s)
 
     Example: user is a super admin
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
-      When "acct1" attempts to disable msg execution
+      When "acct1" attempts to trip the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is disabled
 
     Example: user has no permissions
       Given "acct1" has no permissions
-      When "acct1" attempts to disable msg execution
+      When "acct1" attempts to trip the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect an "unauthorized" error
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is enabled
 
     Example: user has permission for all messages
       Given "acct1" has permission "LEVEL_ALL_MSGS"
-      When "acct1" attempts to disable msg execution
+      When "acct1" attempts to trip the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is disabled
 
     Example: user has permission for the messages
-      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend" and "cosmos.staking.v1beta1.MsgDelegate"
-      When "acct1" attempts to disable msg execution
+      Given "acct1" has permission to trip the circuit breaker for
         """
-        {
-        "msgs": ["cosmos.bank.v1beta1.MsgSend",cosmos.staking.v1beta1.MsgDelegate"]
-        }
+        ["/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"]
+        """
+      When "acct1" attempts to trip the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"] }
         """
       Then expect success
+      And expect that "/cosmos.staking.v1beta1.MsgDelegate" is disabled
 
     Example: user does not have permission for 1 of the messages in the list
-      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend"
-      When "acct1" attempts to disable msg execution
+      Given "acct1" has permission to trip the circuit breaker for
+        """
+        ["/cosmos.bank.v1beta1.MsgSend"]
         """
-        {
-        "msgs": ["cosmos.bank.v1beta1.MsgSend","cosmos.staking.v1beta1.MsgCreateValidator"]
-        }
+      When "acct1" attempts to trip the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgCreateValidator"] }
         """
       Then expect an "unauthorized" error
 
     Example: user does not have permission for the message
-      Given "acct1" has permission to diable "cosmos.bank.v1beta1.MsgSend"
-      When "acct1" attempts to disable msg execution
+      Given "acct1" has permission to trip the circuit breaker for
+        """
+        ["/cosmos.bank.v1beta1.MsgSend"]
+        """
+      When "acct1" attempts to trip the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgMultiSend"] }
         """
       Then expect an "unauthorized" error
 
     Example: user tries to trip an already tripped circuit breaker
-      Given "acct1" has permission to diable "cosmos.bank.v1beta1.MsgSend" & is already tripped
-      When "acct1" attempts to disable msg execution
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      When "acct1" attempts to trip the circuit breaker
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect an "msg disabled" error
+
+  Rule: the circuit breaker can't be tripped for the circuit module's own messages
+
+    Example: user is a super admin
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
+      When "acct1" attempts to trip the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.circuit.v1.MsgResetCircuitBreaker"] }
+        """
+      Then expect an "cannot trip circuit breaker" error
+      And expect that "/cosmos.circuit.v1.MsgResetCircuitBreaker" is enabled
+
+    Example: user has permission for all messages
+      Given "acct1" has permission "LEVEL_ALL_MSGS"
+      When "acct1" attempts to trip the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend", "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker"] }
+        """
+      Then expect an "cannot trip circuit breaker" error
+      And expect that "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker" is enabled
+
+    Example: user has permission for the messages
+      Given "acct1" has permission to trip the circuit breaker for
+        """
+        ["/cosmos.circuit.v1.MsgTripCircuitBreaker"]
+        """
+      When "acct1" attempts to trip the circuit breaker
+        """
+        { "msg_type_urls": ["/cosmos.circuit.v1.MsgTripCircuitBreaker"] }
+        """
+      Then expect an "cannot trip circuit breaker" error
+      And expect that "/cosmos.circuit.v1.MsgTripCircuitBreaker" is enabled
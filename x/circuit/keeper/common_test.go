@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/regen-network/gocuke"
+
+	storetypes "cosmossdk.io/store/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type baseFixture struct {
+	t   gocuke.TestingT
+	err error
+	ctx context.Context
+
+	k         Keeper
+	msgServer types.MsgServer
+	cdc       codec.Codec
+	addrs     map[string]sdk.AccAddress
+	storeKey  *storetypes.KVStoreKey
+	sdkCtx    sdk.Context
+}
+
+func initFixture(t gocuke.TestingT) *baseFixture {
+	s := &baseFixture{t: t}
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	s.cdc = encCfg.Codec
+
+	s.storeKey = storetypes.NewKVStoreKey(types.StoreKey)
+	s.sdkCtx = testutil.DefaultContext(s.storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.k = NewKeeper(s.cdc, s.storeKey, authority.String())
+	s.msgServer = NewMsgServerImpl(s.k)
+
+	s.addrs = map[string]sdk.AccAddress{"authority": authority}
+
+	return s
+}
+
+// addr returns the address associated with the given account name,
+// creating a new one the first time the name is seen.
+func (s *baseFixture) addr(name string) sdk.AccAddress {
+	addr, ok := s.addrs[name]
+	if !ok {
+		addr = sdk.AccAddress(name)
+		s.addrs[name] = addr
+	}
+
+	return addr
+}
+
+func (s *baseFixture) setPermissions(name string, perms *types.CircuitBreakerPermissions) {
+	assert.NilError(s.t, s.k.SetPermissions(s.sdkCtx, s.addr(name), perms))
+}
+
+func (s *baseFixture) HasPermission(a, b string) {
+	level, ok := types.CircuitBreakerPermissions_Level_value[b]
+	assert.Assert(s.t, ok, "unknown permission level %s", b)
+
+	s.setPermissions(a, &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_Level(level)})
+}
+
+func (s *baseFixture) HasNoPermissions(a string) {
+	s.setPermissions(a, &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED})
+}
+
+func (s *baseFixture) ExpectSuccess() {
+	assert.NilError(s.t, s.err)
+}
+
+func (s *baseFixture) ExpectAnError(a string) {
+	assert.ErrorContains(s.t, s.err, a)
+}
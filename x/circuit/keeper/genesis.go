@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k *Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, accounts := range genState.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(accounts.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetPermissions(ctx, addr, accounts.Permissions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		k.DisableMsg(ctx, url)
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var (
+		permissions  []*types.GenesisAccountPermissions
+		disabledMsgs []string
+	)
+
+	k.IteratePermissions(ctx, func(address []byte, perms types.CircuitBreakerPermissions) (stop bool) {
+		permissions = append(permissions, &types.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(address).String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+
+	k.IterateDisableList(ctx, func(msgURL string) (stop bool) {
+		disabledMsgs = append(disabledMsgs, msgURL)
+		return false
+	})
+
+	return types.NewGenesisState(permissions, disabledMsgs)
+}
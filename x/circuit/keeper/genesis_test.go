@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	genesis := types.NewGenesisState(
+		[]*types.GenesisAccountPermissions{
+			{
+				Address:     f.addr("acct1").String(),
+				Permissions: &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN},
+			},
+			{
+				Address: f.addr("acct2").String(),
+				Permissions: &types.CircuitBreakerPermissions{
+					Level:         types.CircuitBreakerPermissions_LEVEL_SOME_MSGS,
+					LimitMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				},
+			},
+		},
+		[]string{"/cosmos.bank.v1beta1.MsgMultiSend", "/cosmos.bank.v1beta1.MsgSend"},
+	)
+	assert.NilError(t, genesis.Validate())
+
+	f.k.InitGenesis(f.sdkCtx, genesis)
+
+	assert.Assert(t, !f.k.IsAllowed(f.sdkCtx, "/cosmos.bank.v1beta1.MsgSend"))
+	assert.Assert(t, f.k.IsAllowed(f.sdkCtx, "/cosmos.staking.v1beta1.MsgDelegate"))
+
+	exported := f.k.ExportGenesis(f.sdkCtx)
+	assert.Equal(t, len(genesis.AccountPermissions), len(exported.AccountPermissions))
+	assert.DeepEqual(t, genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+
+	expected := make(map[string]string, len(genesis.AccountPermissions))
+	for _, account := range genesis.AccountPermissions {
+		expected[account.Address] = account.Permissions.String()
+	}
+
+	for _, account := range exported.AccountPermissions {
+		assert.Equal(t, expected[account.Address], account.Permissions.String())
+	}
+}
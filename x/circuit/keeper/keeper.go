@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper defines the circuit module's keeper.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	authority string
+}
+
+// NewKeeper constructs a new Circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetPermissions returns the circuit breaker permissions of the given
+// account. Accounts without permissions get a LEVEL_NONE_UNSPECIFIED
+// permission set.
+func (k *Keeper) GetPermissions(ctx sdk.Context, address []byte) (*types.CircuitBreakerPermissions, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	perms := &types.CircuitBreakerPermissions{}
+	bz := store.Get(types.CreateAddressPrefix(address))
+	if bz == nil {
+		return perms, nil
+	}
+
+	if err := k.cdc.Unmarshal(bz, perms); err != nil {
+		return nil, err
+	}
+
+	return perms, nil
+}
+
+// SetPermissions sets the circuit breaker permissions of the given account.
+// Setting LEVEL_NONE_UNSPECIFIED removes all permissions of the account.
+func (k *Keeper) SetPermissions(ctx sdk.Context, address []byte, perms *types.CircuitBreakerPermissions) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if perms.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(types.CreateAddressPrefix(address))
+		return nil
+	}
+
+	bz, err := k.cdc.Marshal(perms)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.CreateAddressPrefix(address), bz)
+	return nil
+}
+
+// IteratePermissions iterates over all the accounts holding circuit breaker
+// permissions. The iteration stops when the callback returns true.
+func (k *Keeper) IteratePermissions(ctx sdk.Context, cb func(address []byte, perms types.CircuitBreakerPermissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var perms types.CircuitBreakerPermissions
+		k.cdc.MustUnmarshal(iter.Value(), &perms)
+
+		if cb(iter.Key(), perms) {
+			break
+		}
+	}
+}
+
+// IsAllowed returns true when the given Msg type URL has not been disabled
+// by the circuit breaker.
+func (k *Keeper) IsAllowed(ctx sdk.Context, msgURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return !store.Has(types.CreateDisableMsgPrefix(msgURL))
+}
+
+// DisableMsg disables execution of the given Msg type URL.
+func (k *Keeper) DisableMsg(ctx sdk.Context, msgURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateDisableMsgPrefix(msgURL), []byte{})
+}
+
+// EnableMsg re-enables execution of the given Msg type URL.
+func (k *Keeper) EnableMsg(ctx sdk.Context, msgURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateDisableMsgPrefix(msgURL))
+}
+
+// IterateDisableList iterates over all the disabled Msg type URLs. The
+// iteration stops when the callback returns true.
+func (k *Keeper) IterateDisableList(ctx sdk.Context, cb func(msgURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisableListPrefix)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key())) {
+			break
+		}
+	}
+}
@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestAuthorize(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &authorizeSuite{}).Path("../features/msg_authorize.feature").Run()
 }
 
@@ -16,30 +17,26 @@ type authorizeSuite struct {
 	*baseFixture
 }
 
-func (s *authorizeSuite) Before(t *testing.T) {
+func (s *authorizeSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *authorizeSuite) HasPermission(a string, b string) {
-	panic("PENDING")
-}
-
-func (s *authorizeSuite) HasNoPermissions(a string) {
-	panic("PENDING")
-}
-
-func (s *authorizeSuite) AttemptsToGrantThePermissions(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
-}
+func (s *authorizeSuite) AttemptsToGrantThePermissions(a, b string, c gocuke.DocString) {
+	var perms types.CircuitBreakerPermissions
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(c.Content), &perms))
 
-func (s *authorizeSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+	msg := types.NewMsgAuthorizeCircuitBreaker(s.addr(a).String(), s.addr(b).String(), &perms)
+	_, s.err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, msg)
 }
 
-func (s *authorizeSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+func (s *authorizeSuite) ExpectThatHasPermission(a, b string) {
+	perms, err := s.k.GetPermissions(s.sdkCtx, s.addr(a))
+	assert.NilError(s.t, err)
+	assert.Equal(s.t, b, perms.Level.String())
 }
 
 func (s *authorizeSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	perms, err := s.k.GetPermissions(s.sdkCtx, s.addr(a))
+	assert.NilError(s.t, err)
+	assert.Equal(s.t, types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED, perms.Level)
 }
@@ -1,14 +1,16 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestReset(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &resetSuite{}).Path("../features/msg_reset.feature").Run()
 }
 
@@ -16,30 +18,36 @@ type resetSuite struct {
 	*baseFixture
 }
 
-func (s *resetSuite) Before(t *testing.T) {
+func (s *resetSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *resetSuite) HasPermission(a string, b string) {
-	panic("PENDING")
-}
+func (s *resetSuite) HasPermissionToTripTheCircuitBreakerFor(a string, b gocuke.DocString) {
+	var msgURLs []string
+	assert.NilError(s.t, json.Unmarshal([]byte(b.Content), &msgURLs))
 
-func (s *resetSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+	s.setPermissions(a, &types.CircuitBreakerPermissions{
+		Level:         types.CircuitBreakerPermissions_LEVEL_SOME_MSGS,
+		LimitMsgTypes: msgURLs,
+	})
 }
 
-func (s *resetSuite) AttemptsToResetCircuit(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *resetSuite) IsDisabled(a string) {
+	s.k.DisableMsg(s.sdkCtx, a)
 }
 
-func (s *resetSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+func (s *resetSuite) AttemptsToResetTheCircuitBreaker(a string, b gocuke.DocString) {
+	var msg types.MsgResetCircuitBreaker
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(b.Content), &msg))
+
+	msg.Authority = s.addr(a).String()
+	_, s.err = s.msgServer.ResetCircuitBreaker(s.ctx, &msg)
 }
 
-func (s *resetSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+func (s *resetSuite) ExpectThatIsDisabled(a string) {
+	assert.Assert(s.t, !s.k.IsAllowed(s.sdkCtx, a))
 }
 
-func (s *resetSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+func (s *resetSuite) ExpectThatIsEnabled(a string) {
+	assert.Assert(s.t, s.k.IsAllowed(s.sdkCtx, a))
 }
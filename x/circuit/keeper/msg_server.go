@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker grants (or revokes) another account's circuit
+// breaker permissions. Only the module authority and super admins can
+// authorize accounts.
+func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granterPerms, err := srv.accountPermissions(ctx, msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if granterPerms.Level != types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only super admins can authorize accounts")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	if msg.Permissions == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("permissions cannot be nil")
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := srv.SetPermissions(ctx, grantee, msg.Permissions); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermission, msg.Permissions.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker disables execution of the given Msg type URLs. The
+// circuit module's own Msgs can't be disabled, as a tripped reset could never
+// be undone.
+func (srv msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := srv.accountPermissions(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg type urls cannot be empty")
+	}
+
+	for _, msgURL := range msg.MsgTypeUrls {
+		if isCircuitMsg(msgURL) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot trip circuit breaker for %s", msgURL)
+		}
+
+		if !canActOn(perms, msgURL) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("account does not have permission to trip circuit breaker for %s", msgURL)
+		}
+
+		if !srv.IsAllowed(ctx, msgURL) {
+			return nil, types.ErrMsgDisabled.Wrapf("%s is already disabled", msgURL)
+		}
+
+		srv.DisableMsg(ctx, msgURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker re-enables execution of the given Msg type URLs. When
+// no type URLs are provided, every disabled Msg type URL the account has
+// permission for is re-enabled.
+func (srv msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := srv.accountPermissions(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	msgURLs := msg.MsgTypeUrls
+	if len(msgURLs) == 0 {
+		if perms.Level == types.CircuitBreakerPermissions_LEVEL_NONE_UNSPECIFIED {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("account does not have permission to reset the circuit breaker")
+		}
+
+		srv.IterateDisableList(ctx, func(msgURL string) (stop bool) {
+			if canActOn(perms, msgURL) {
+				msgURLs = append(msgURLs, msgURL)
+			}
+			return false
+		})
+	}
+
+	for _, msgURL := range msgURLs {
+		if !canActOn(perms, msgURL) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("account does not have permission to reset circuit breaker for %s", msgURL)
+		}
+
+		if srv.IsAllowed(ctx, msgURL) {
+			return nil, types.ErrMsgNotDisabled.Wrapf("%s is not disabled", msgURL)
+		}
+
+		srv.EnableMsg(ctx, msgURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msgURLs, ",")),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
+// accountPermissions returns the permissions of the given bech32 address.
+// The module authority always acts as a super admin.
+func (srv msgServer) accountPermissions(ctx sdk.Context, address string) (*types.CircuitBreakerPermissions, error) {
+	if address == srv.GetAuthority() {
+		return &types.CircuitBreakerPermissions{Level: types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return srv.GetPermissions(ctx, addr)
+}
+
+// canActOn returns true when the given permissions allow tripping or
+// resetting the circuit breaker for the given Msg type URL.
+func canActOn(perms *types.CircuitBreakerPermissions, msgURL string) bool {
+	switch perms.Level {
+	case types.CircuitBreakerPermissions_LEVEL_SUPER_ADMIN, types.CircuitBreakerPermissions_LEVEL_ALL_MSGS:
+		return true
+	case types.CircuitBreakerPermissions_LEVEL_SOME_MSGS:
+		for _, url := range perms.LimitMsgTypes {
+			if url == msgURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isCircuitMsg returns true when the given Msg type URL is one of the circuit
+// module's own Msgs.
+func isCircuitMsg(msgURL string) bool {
+	switch msgURL {
+	case sdk.MsgTypeURL(&types.MsgAuthorizeCircuitBreaker{}),
+		sdk.MsgTypeURL(&types.MsgTripCircuitBreaker{}),
+		sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{}):
+		return true
+	}
+
+	return false
+}
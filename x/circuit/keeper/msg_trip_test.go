@@ -1,14 +1,16 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestTrip(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &tripSuite{}).Path("../features/msg_trip.feature").Run()
 }
 
@@ -16,30 +18,36 @@ type tripSuite struct {
 	*baseFixture
 }
 
-func (s *tripSuite) Before(t *testing.T) {
+func (s *tripSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *tripSuite) HasPermission(a string, b string) {
-	panic("PENDING")
-}
+func (s *tripSuite) HasPermissionToTripTheCircuitBreakerFor(a string, b gocuke.DocString) {
+	var msgURLs []string
+	assert.NilError(s.t, json.Unmarshal([]byte(b.Content), &msgURLs))
 
-func (s *tripSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+	s.setPermissions(a, &types.CircuitBreakerPermissions{
+		Level:         types.CircuitBreakerPermissions_LEVEL_SOME_MSGS,
+		LimitMsgTypes: msgURLs,
+	})
 }
 
-func (s *tripSuite) AttemptsToTripCircuit(a string, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *tripSuite) IsDisabled(a string) {
+	s.k.DisableMsg(s.sdkCtx, a)
 }
 
-func (s *tripSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+func (s *tripSuite) AttemptsToTripTheCircuitBreaker(a string, b gocuke.DocString) {
+	var msg types.MsgTripCircuitBreaker
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(b.Content), &msg))
+
+	msg.Authority = s.addr(a).String()
+	_, s.err = s.msgServer.TripCircuitBreaker(s.ctx, &msg)
 }
 
-func (s *tripSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+func (s *tripSuite) ExpectThatIsDisabled(a string) {
+	assert.Assert(s.t, !s.k.IsAllowed(s.sdkCtx, a))
 }
 
-func (s *tripSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+func (s *tripSuite) ExpectThatIsEnabled(a string) {
+	assert.Assert(s.t, s.k.IsAllowed(s.sdkCtx, a))
 }
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+// QueryServer implements the circuit module's gRPC query service.
+type QueryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the circuit QueryServer
+// interface for the provided Keeper.
+func NewQueryServer(keeper Keeper) types.QueryServer {
+	return &QueryServer{keeper: keeper}
+}
+
+// Account returns the circuit breaker permissions of an account.
+func (qs QueryServer) Account(goCtx context.Context, req *types.QueryAccountRequest) (*types.AccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	perms, err := qs.keeper.GetPermissions(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountResponse{Permission: perms}, nil
+}
+
+// Accounts returns the circuit breaker permissions of all accounts.
+func (qs QueryServer) Accounts(goCtx context.Context, req *types.QueryAccountsRequest) (*types.AccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(qs.keeper.storeKey), types.AccountPermissionPrefix)
+
+	var accounts []*types.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		perms := &types.CircuitBreakerPermissions{}
+		if err := qs.keeper.cdc.Unmarshal(value, perms); err != nil {
+			return err
+		}
+
+		accounts = append(accounts, &types.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(key).String(),
+			Permissions: perms,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the Msg type URLs that are currently disabled.
+func (qs QueryServer) DisabledList(goCtx context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var msgURLs []string
+	qs.keeper.IterateDisableList(ctx, func(msgURL string) (stop bool) {
+		msgURLs = append(msgURLs, msgURL)
+		return false
+	})
+
+	return &types.DisabledListResponse{DisabledList: msgURLs}, nil
+}
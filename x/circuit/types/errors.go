@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/circuit module sentinel errors
+var (
+	ErrMsgDisabled    = errors.Register(ModuleName, 2, "msg disabled")
+	ErrMsgNotDisabled = errors.Register(ModuleName, 3, "msg not disabled")
+)
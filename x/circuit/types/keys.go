@@ -0,0 +1,27 @@
+package types
+
+const (
+	// ModuleName defines the name of the x/circuit module.
+	ModuleName = "circuit"
+
+	// StoreKey defines the module's store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+)
+
+// CreateAddressPrefix returns the store key under which the permissions of
+// the given account are stored.
+func CreateAddressPrefix(address []byte) []byte {
+	return append(append([]byte{}, AccountPermissionPrefix...), address...)
+}
+
+// CreateDisableMsgPrefix returns the store key under which the disabled
+// state of the given Msg type URL is stored.
+func CreateDisableMsgPrefix(msgURL string) []byte {
+	return append(append([]byte{}, DisableListPrefix...), []byte(msgURL)...)
+}
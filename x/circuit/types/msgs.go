@@ -0,0 +1,153 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_, _, _ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permission *CircuitBreakerPermissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permission,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgAuthorizeCircuitBreaker.
+func (msg MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgAuthorizeCircuitBreaker
+func (msg MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	if msg.Permissions == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("permissions cannot be nil")
+	}
+
+	return msg.Permissions.Validate()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, urls []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgTripCircuitBreaker.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgTripCircuitBreaker
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	// tripping every message would also disable the circuit breaker
+	// messages themselves, leaving no way to reset the circuit.
+	if len(msg.MsgTypeUrls) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type urls cannot be empty")
+	}
+
+	return validateTypeURLs(msg.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgResetCircuitBreaker.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgResetCircuitBreaker
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateTypeURLs(msg.MsgTypeUrls)
+}
+
+// Validate checks that the permission level and the list of Msg type URLs
+// are consistent with each other.
+func (p CircuitBreakerPermissions) Validate() error {
+	if _, ok := CircuitBreakerPermissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown permission level %d", p.Level)
+	}
+
+	if p.Level == CircuitBreakerPermissions_LEVEL_SOME_MSGS {
+		if len(p.LimitMsgTypes) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("limit msg types cannot be empty when using LEVEL_SOME_MSGS")
+		}
+
+		return validateTypeURLs(p.LimitMsgTypes)
+	}
+
+	if len(p.LimitMsgTypes) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("limit msg types can only be used with LEVEL_SOME_MSGS, got %s", p.Level)
+	}
+
+	return nil
+}
+
+func validateTypeURLs(urls []string) error {
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		if url == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("msg type url cannot be empty")
+		}
+
+		if seen[url] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate msg type url %s", url)
+		}
+		seen[url] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the expected feemarket keeper.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (types.Params, error)
+	GetBaseFee(ctx sdk.Context) (sdk.Dec, error)
+}
+
+// NewTxFeeChecker returns a TxFeeChecker, to be used with the DeductFeeDecorator,
+// requiring the txs to pay at least the base fee of the fee market per unit of gas.
+// Unlike the minimum gas prices of the validators, the base fee is part of the
+// consensus and is checked in DeliverTx as well. In CheckTx the minimum gas price
+// of the validator for the fee denom applies on top of the base fee.
+// The priority of a tx is the tip it pays per unit of gas above the base fee.
+// When the fee market is disabled, the default validator minimum gas prices check is used.
+func NewTxFeeChecker(fmk FeeMarketKeeper) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		params, err := fmk.GetParams(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// genesis txs are not subject to the base fee
+		if !params.Enabled || ctx.BlockHeight() == 0 {
+			return authante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		baseFee, err := fmk.GetBaseFee(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		gasPrice := baseFee
+		if ctx.IsCheckTx() {
+			gasPrice = sdk.MaxDec(gasPrice, ctx.MinGasPrices().AmountOf(params.FeeDenom))
+		}
+
+		// fee = ceil(gasPrice * gasLimit)
+		glDec := sdk.NewDec(int64(gas))
+		requiredFee := sdk.NewCoin(params.FeeDenom, gasPrice.Mul(glDec).Ceil().RoundInt())
+		paidFee := sdk.NewCoin(params.FeeDenom, feeCoins.AmountOf(params.FeeDenom))
+		if paidFee.IsLT(requiredFee) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFee)
+		}
+
+		priority := getTxPriority(paidFee, baseFee, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
+
+// getTxPriority returns the tip paid per unit of gas above the base fee.
+func getTxPriority(fee sdk.Coin, baseFee sdk.Dec, gas int64) int64 {
+	if gas <= 0 {
+		return 0
+	}
+
+	tip := sdk.NewDecFromInt(fee.Amount).QuoInt64(gas).Sub(baseFee).TruncateInt()
+	if !tip.IsInt64() {
+		return math.MaxInt64
+	}
+	return tip.Int64()
+}
@@ -0,0 +1,124 @@
+package ante_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type mockFeeMarketKeeper struct {
+	params  types.Params
+	baseFee sdk.Dec
+}
+
+func (k mockFeeMarketKeeper) GetParams(sdk.Context) (types.Params, error) { return k.params, nil }
+
+func (k mockFeeMarketKeeper) GetBaseFee(sdk.Context) (sdk.Dec, error) { return k.baseFee, nil }
+
+type mockFeeTx struct {
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return nil }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestTxFeeChecker(t *testing.T) {
+	params := types.DefaultParams()
+	params.Enabled = true
+	checker := ante.NewTxFeeChecker(mockFeeMarketKeeper{params: params, baseFee: sdk.NewDecWithPrec(5, 1)})
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 2}, false, log.NewNopLogger()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	testCases := []struct {
+		name             string
+		ctx              sdk.Context
+		tx               mockFeeTx
+		expectedErr      error
+		expectedPriority int64
+	}{
+		{
+			name:             "fee covering the base fee",
+			ctx:              ctx,
+			tx:               mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), gas: 100},
+			expectedPriority: 0,
+		},
+		{
+			name:             "tip above the base fee",
+			ctx:              ctx,
+			tx:               mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 250)), gas: 100},
+			expectedPriority: 2,
+		},
+		{
+			name:        "fee below the base fee",
+			ctx:         ctx,
+			tx:          mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 49)), gas: 100},
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "fee in another denom",
+			ctx:         ctx,
+			tx:          mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), gas: 100},
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "validator min gas price above the base fee",
+			ctx: ctx.WithIsCheckTx(true).
+				WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1)))),
+			tx:          mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), gas: 100},
+			expectedErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "validator min gas price ignored in DeliverTx",
+			ctx:  ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1)))),
+			tx:   mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), gas: 100},
+		},
+		{
+			name: "genesis tx",
+			ctx:  ctx.WithBlockHeight(0),
+			tx:   mockFeeTx{gas: 100},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, priority, err := checker(tc.ctx, tc.tx)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.tx.fee, fee)
+			require.Equal(t, tc.expectedPriority, priority)
+		})
+	}
+}
+
+func TestTxFeeChecker_Disabled(t *testing.T) {
+	checker := ante.NewTxFeeChecker(mockFeeMarketKeeper{params: types.DefaultParams(), baseFee: sdk.NewDec(1)})
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 2}, true, log.NewNopLogger())
+
+	// the base fee is not enforced
+	_, _, err := checker(ctx, mockFeeTx{gas: 100})
+	require.NoError(t, err)
+
+	// the validator min gas prices are
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(1))))
+	_, _, err = checker(ctx, mockFeeTx{gas: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema  collections.Schema
+	Params  collections.Item[types.Params]
+	BaseFee collections.Item[sdk.Dec]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key.(*storetypes.KVStoreKey)))
+	k := Keeper{
+		cdc:       cdc,
+		authority: authority,
+		Params:    collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:   collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// GetBaseFee returns the base fee, i.e. the minimum gas price in the fee denom
+// required by the transactions of the current block.
+func (k Keeper) GetBaseFee(ctx sdk.Context) (sdk.Dec, error) {
+	return k.BaseFee.Get(ctx)
+}
+
+// UpdateBaseFee computes the base fee of the next block from the gas consumed
+// by the current block, as tracked by the block gas meter, and stores it.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee, err := k.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	var maxBlockGas int64
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		maxBlockGas = cp.Block.MaxGas
+	}
+
+	var gasUsed uint64
+	if gasMeter := ctx.BlockGasMeter(); gasMeter != nil {
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}
+
+	baseFee = params.NextBaseFee(baseFee, gasUsed, maxBlockGas)
+	if err := k.BaseFee.Set(ctx, baseFee); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	feemarketKeeper keeper.Keeper
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+	queryServer     types.QueryServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithConsensusParams(&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}})
+
+	s.feemarketKeeper = keeper.NewKeeper(encCfg.Codec, key, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	s.feemarketKeeper.InitGenesis(s.ctx, types.NewGenesisState(params, sdk.NewDec(1)))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feemarketKeeper)
+	s.queryServer = keeper.NewQuerier(s.feemarketKeeper)
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	testCases := []struct {
+		name     string
+		gasUsed  uint64
+		expected sdk.Dec
+	}{
+		{"empty block", 0, sdk.NewDecWithPrec(875, 3)},
+		{"target gas used", 500, sdk.NewDec(1)},
+		{"full block", 1000, sdk.NewDecWithPrec(1125, 3)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			gasMeter := storetypes.NewGasMeter(1000)
+			gasMeter.ConsumeGas(tc.gasUsed, "txs")
+			ctx := s.ctx.WithBlockGasMeter(gasMeter)
+
+			feemarket.EndBlocker(ctx, s.feemarketKeeper)
+
+			baseFee, err := s.feemarketKeeper.GetBaseFee(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, baseFee)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFee_Disabled() {
+	s.Require().NoError(s.feemarketKeeper.SetParams(s.ctx, types.DefaultParams()))
+
+	ctx := s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(1000))
+	feemarket.EndBlocker(ctx, s.feemarketKeeper)
+
+	baseFee, err := s.feemarketKeeper.GetBaseFee(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(1), baseFee)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := s.feemarketKeeper.ExportGenesis(s.ctx)
+	s.Require().True(gs.Params.Enabled)
+	s.Require().Equal(sdk.NewDec(1), gs.BaseFee)
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	res, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().True(res.Enabled)
+	s.Require().Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), res.BaseFee)
+
+	paramsRes, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.DefaultBondDenom, paramsRes.Params.FeeDenom)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.ElasticityMultiplier = 4
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "set invalid authority",
+			request:   &types.MsgUpdateParams{Authority: "foo", Params: params},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feemarketKeeper.GetAuthority(),
+				Params:    types.NewParams(true, sdk.DefaultBondDenom, sdk.ZeroDec(), 8, 2),
+			},
+			expectErr: true,
+		},
+		{
+			name:      "set full valid params",
+			request:   &types.MsgUpdateParams{Authority: s.feemarketKeeper.GetAuthority(), Params: params},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			got, err := s.feemarketKeeper.GetParams(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Params, got)
+		})
+	}
+}
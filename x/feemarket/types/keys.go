@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the prefix of the module params.
+	ParamsKey = collections.NewPrefix(0)
+	// BaseFeeKey is the prefix of the base fee.
+	BaseFeeKey = collections.NewPrefix(1)
+)
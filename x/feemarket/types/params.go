@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee sdk.Dec, baseFeeChangeDenominator, elasticityMultiplier uint32) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters. The fee market
+// is disabled by default, and follows the EIP-1559 values once enabled: blocks
+// target half of the maximum block gas and the base fee changes by at most
+// 12.5% between blocks.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.NewDecWithPrec(1, 3),
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+	// a zero base fee would never increase
+	if p.Enabled && !p.MinBaseFee.IsPositive() {
+		return errors.New("min base fee must be positive when the fee market is enabled")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator cannot be 0")
+	}
+	if p.ElasticityMultiplier == 0 {
+		return errors.New("elasticity multiplier cannot be 0")
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the block following a block which used
+// gasUsed gas out of maxBlockGas, following EIP-1559: the base fee increases
+// when the block used more gas than the target gas and decreases when it used
+// less, by at most 1/BaseFeeChangeDenominator of its value. The base fee is
+// never lower than MinBaseFee, and is left unchanged when the maximum block gas
+// is unlimited.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed uint64, maxBlockGas int64) sdk.Dec {
+	targetGas := maxBlockGas / int64(p.ElasticityMultiplier)
+	if targetGas <= 0 {
+		return sdk.MaxDec(baseFee, p.MinBaseFee)
+	}
+
+	if gasUsed > uint64(maxBlockGas) {
+		gasUsed = uint64(maxBlockGas)
+	}
+
+	// delta = baseFee * |gasUsed - targetGas| / targetGas / BaseFeeChangeDenominator
+	gasDelta := sdk.NewDec(int64(gasUsed) - targetGas).Abs()
+	delta := baseFee.Mul(gasDelta).QuoInt64(targetGas).QuoInt64(int64(p.BaseFeeChangeDenominator))
+
+	next := baseFee
+	switch {
+	case int64(gasUsed) > targetGas:
+		next = baseFee.Add(delta)
+	case int64(gasUsed) < targetGas:
+		next = baseFee.Sub(delta)
+	}
+
+	return sdk.MaxDec(next, p.MinBaseFee)
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	require.NoError(t, params.Validate())
+
+	params.MinBaseFee = sdk.ZeroDec()
+	require.ErrorContains(t, params.Validate(), "must be positive")
+
+	params = types.DefaultParams()
+	params.FeeDenom = ""
+	require.ErrorContains(t, params.Validate(), "invalid fee denom")
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ElasticityMultiplier = 0
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	baseFee := sdk.NewDec(1)
+
+	testCases := []struct {
+		name        string
+		baseFee     sdk.Dec
+		gasUsed     uint64
+		maxBlockGas int64
+		expected    sdk.Dec
+	}{
+		{"target gas used", baseFee, 50, 100, baseFee},
+		{"full block", baseFee, 100, 100, sdk.NewDecWithPrec(1125, 3)},
+		{"more than the maximum gas used", baseFee, 200, 100, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block", baseFee, 0, 100, sdk.NewDecWithPrec(875, 3)},
+		{"quarter full block", baseFee, 25, 100, sdk.NewDecWithPrec(9375, 4)},
+		{"min base fee", sdk.NewDecWithPrec(1, 2), 0, 100, sdk.NewDecWithPrec(1, 2)},
+		{"unlimited block gas", baseFee, 1000, -1, baseFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxBlockGas))
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	require.ErrorContains(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.ZeroDec())), "min base fee")
+	require.NoError(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.NewDec(1))))
+}
@@ -45,7 +45,7 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0","max_supply":"0"}`,
 		},
 		{
 			"text output",
@@ -56,6 +56,7 @@ goal_bonded: "0"
 inflation_max: "0"
 inflation_min: "0"
 inflation_rate_change: "0"
+max_supply: "0"
 mint_denom: ""`,
 		},
 	}
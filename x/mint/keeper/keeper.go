@@ -117,6 +117,27 @@ func (k Keeper) BondedRatio(ctx sdk.Context) math.LegacyDec {
 	return k.stakingKeeper.BondedRatio(ctx)
 }
 
+// CapProvision returns the given provision truncated such that minting it does
+// not push the total supply of its denom above the MaxSupply parameter. A zero
+// coin is returned once the maximum supply has been reached. The provision is
+// returned unchanged if MaxSupply is zero, i.e. the supply is unbounded.
+func (k Keeper) CapProvision(ctx sdk.Context, params types.Params, provision sdk.Coin) sdk.Coin {
+	if params.MaxSupply.IsNil() || !params.MaxSupply.IsPositive() {
+		return provision
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, provision.Denom)
+	if supply.Amount.GTE(params.MaxSupply) {
+		return sdk.NewCoin(provision.Denom, math.ZeroInt())
+	}
+
+	if remaining := params.MaxSupply.Sub(supply.Amount); provision.Amount.GT(remaining) {
+		return sdk.NewCoin(provision.Denom, remaining)
+	}
+
+	return provision
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
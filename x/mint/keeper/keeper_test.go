@@ -84,6 +84,7 @@ func (s *IntegrationTestSuite) TestParams() {
 				InflationMin:        sdk.NewDecWithPrec(7, 2),
 				GoalBonded:          sdk.NewDecWithPrec(67, 2),
 				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				MaxSupply:           sdk.ZeroInt(),
 			},
 			expectErr: true,
 		},
@@ -96,6 +97,7 @@ func (s *IntegrationTestSuite) TestParams() {
 				InflationMin:        sdk.NewDecWithPrec(2, 2),
 				GoalBonded:          sdk.NewDecWithPrec(37, 2),
 				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				MaxSupply:           sdk.ZeroInt(),
 			},
 			expectErr: false,
 		},
@@ -138,3 +140,37 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestCapProvision() {
+	provision := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		maxSupply sdk.Int
+		supply    sdk.Int
+		expected  sdk.Int
+	}{
+		{"unbounded supply", sdk.ZeroInt(), sdk.NewInt(1000), sdk.NewInt(100)},
+		{"below max supply", sdk.NewInt(2000), sdk.NewInt(1000), sdk.NewInt(100)},
+		{"reaching max supply", sdk.NewInt(1050), sdk.NewInt(1000), sdk.NewInt(50)},
+		{"max supply reached", sdk.NewInt(1000), sdk.NewInt(1000), sdk.ZeroInt()},
+		{"max supply exceeded", sdk.NewInt(500), sdk.NewInt(1000), sdk.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.MaxSupply = tc.maxSupply
+
+			if tc.maxSupply.IsPositive() {
+				s.bankKeeper.EXPECT().GetSupply(s.ctx, sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, tc.supply))
+			}
+
+			capped := s.mintKeeper.CapProvision(s.ctx, params, provision)
+			s.Require().Equal(sdk.DefaultBondDenom, capped.Denom)
+			s.Require().True(tc.expected.Equal(capped.Amount), "expected %s, got %s", tc.expected, capped.Amount)
+		})
+	}
+}
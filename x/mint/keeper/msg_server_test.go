@@ -29,6 +29,7 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 					InflationMin:        sdk.NewDecWithPrec(7, 2),
 					GoalBonded:          sdk.NewDecWithPrec(67, 2),
 					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					MaxSupply:           sdk.ZeroInt(),
 				},
 			},
 			expectErr: true,
@@ -44,6 +45,7 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 					InflationMin:        sdk.NewDecWithPrec(2, 2),
 					GoalBonded:          sdk.NewDecWithPrec(37, 2),
 					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					MaxSupply:           sdk.ZeroInt(),
 				},
 			},
 			expectErr: false,
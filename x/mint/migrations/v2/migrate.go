@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -28,6 +29,12 @@ func Migrate(
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
+	// MaxSupply is not tracked by the legacy subspace, default to an unbounded
+	// supply.
+	if currParams.MaxSupply.IsNil() {
+		currParams.MaxSupply = math.ZeroInt()
+	}
+
 	if err := currParams.Validate(); err != nil {
 		return err
 	}
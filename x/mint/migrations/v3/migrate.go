@@ -0,0 +1,47 @@
+package v3
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const (
+	ModuleName = "mint"
+)
+
+var ParamsKey = []byte{0x01}
+
+// Migrate migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it sets the MaxSupply parameter, which did not
+// exist in version 2, to zero, i.e. an unbounded supply.
+func Migrate(
+	_ sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	if err := cdc.Unmarshal(bz, &currParams); err != nil {
+		return err
+	}
+
+	if currParams.MaxSupply.IsNil() {
+		currParams.MaxSupply = math.ZeroInt()
+	}
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&currParams))
+
+	return nil
+}
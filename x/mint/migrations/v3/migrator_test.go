@@ -0,0 +1,38 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	v3 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v3"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params as stored by consensus version 2, without a max supply
+	oldParams := types.DefaultParams()
+	oldParams.MaxSupply = math.Int{}
+	store.Set(v3.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v3.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, types.DefaultParams(), res)
+}
@@ -81,6 +81,9 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// maximum supply of the mint denom. Once the total supply reaches this
+	// value no further tokens are minted. Zero means the supply is unbounded.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,34 +141,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0x8a, 0x4d, 0x18, 0xdd, 0xe8, 0xce, 0x6a, 0x52, 0x37, 0xd9, 0xee, 0x66, 0x0f,
-	0x04, 0x49, 0x68, 0x43, 0xbc, 0x19, 0x2f, 0x02, 0x57, 0x12, 0xd2, 0x9b, 0x5c, 0x9a, 0x69, 0x3b,
-	0x94, 0x09, 0xed, 0x0c, 0x99, 0x19, 0x08, 0x7c, 0x05, 0x4f, 0x5e, 0xfd, 0x06, 0x1e, 0x39, 0xf8,
-	0x21, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x81, 0xaf, 0x61, 0x3a, 0xd3, 0x14, 0xe3, 0xc1,
-	0x8b, 0x78, 0xe9, 0x9f, 0xe7, 0x79, 0xfb, 0x7b, 0x9e, 0x36, 0x7d, 0xa1, 0x1b, 0x73, 0x99, 0x73,
-	0xe9, 0xe7, 0x94, 0x29, 0x7f, 0xd1, 0x89, 0x88, 0xc2, 0x1d, 0x7d, 0xe3, 0xcd, 0x04, 0x57, 0x1c,
-	0x5d, 0x19, 0xdf, 0xd3, 0x52, 0xe9, 0x5f, 0x3f, 0x4f, 0x79, 0xca, 0xb5, 0xef, 0x17, 0x57, 0x66,
-	0xf4, 0xfa, 0xa5, 0x19, 0x0d, 0x8d, 0x51, 0x3e, 0x67, 0xac, 0x4b, 0x9c, 0x53, 0xc6, 0x7d, 0x7d,
-	0x34, 0xd2, 0xfd, 0x57, 0x00, 0xed, 0x01, 0x65, 0x8a, 0x08, 0x34, 0x82, 0x75, 0xca, 0xc6, 0x19,
-	0x56, 0x94, 0x33, 0x07, 0xdc, 0x81, 0x66, 0xbd, 0xfb, 0x76, 0xb3, 0xbb, 0xb5, 0x7e, 0xec, 0x6e,
-	0x1b, 0x29, 0x55, 0x93, 0x79, 0xe4, 0xc5, 0x3c, 0x2f, 0x89, 0xe5, 0xa9, 0x2d, 0x93, 0xa9, 0xaf,
-	0x56, 0x33, 0x22, 0xbd, 0x3e, 0x89, 0xbf, 0x7d, 0x69, 0xc3, 0x32, 0xb0, 0x4f, 0xe2, 0xe0, 0x84,
-	0x43, 0x14, 0x5e, 0x62, 0xc6, 0xe6, 0x38, 0x2b, 0x6a, 0x2d, 0xa8, 0xa4, 0x9c, 0x49, 0xe7, 0xc1,
-	0x19, 0x32, 0x9e, 0x19, 0xec, 0xb0, 0xa2, 0xde, 0x7f, 0xaa, 0x41, 0x7b, 0x88, 0x05, 0xce, 0x25,
-	0xba, 0x81, 0xb0, 0xf8, 0x60, 0x61, 0x42, 0x18, 0xcf, 0xcd, 0x2b, 0x05, 0xf5, 0x42, 0xe9, 0x17,
-	0x02, 0x9a, 0xc3, 0x17, 0x55, 0xc3, 0x50, 0x60, 0x45, 0xc2, 0x78, 0x82, 0x59, 0x4a, 0xca, 0x62,
-	0xef, 0xfe, 0xa5, 0xd8, 0xe7, 0xe3, 0xba, 0x05, 0x82, 0xab, 0x8a, 0x1f, 0x60, 0x45, 0x7a, 0x9a,
-	0x8e, 0xc6, 0xf0, 0xe2, 0x14, 0x9b, 0xe3, 0xa5, 0xf3, 0xf0, 0x5c, 0x71, 0x4f, 0x2a, 0xee, 0x00,
-	0x2f, 0xff, 0xc8, 0xa1, 0xcc, 0xa9, 0xfd, 0x87, 0x1c, 0xca, 0x50, 0x04, 0x1f, 0xa7, 0x1c, 0x67,
-	0x61, 0xc4, 0x59, 0x42, 0x12, 0xe7, 0xd1, 0xb9, 0x52, 0x60, 0x41, 0xed, 0x6a, 0x28, 0x6a, 0xc0,
-	0xa7, 0x51, 0xc6, 0xe3, 0xa9, 0x0c, 0x67, 0x44, 0x84, 0x2b, 0x82, 0x85, 0x63, 0xdf, 0x81, 0x66,
-	0x2d, 0xb8, 0x30, 0xf2, 0x90, 0x88, 0xf7, 0x04, 0x8b, 0x37, 0x37, 0x1f, 0x8e, 0xeb, 0x96, 0xf3,
-	0x5b, 0xc2, 0xd2, 0xac, 0x94, 0xf9, 0x21, 0xba, 0xbd, 0xcd, 0xde, 0x05, 0xdb, 0xbd, 0x0b, 0x7e,
-	0xee, 0x5d, 0xf0, 0xf1, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0x7d, 0x3f, 0xb8, 0xd6, 0xe8, 0xd5, 0x5f,
-	0x7b, 0x96, 0x14, 0x5d, 0x37, 0xb2, 0xf5, 0xe6, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0xf6, 0x61, 0x3e, 0xb4, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0x63, 0x08, 0x41, 0x31, 0x54, 0x50, 0x17, 0x24, 0x53, 0xa9, 0xd7, 0xaa, 0x43, 0x55,
+	0x2a, 0x35, 0xa7, 0x8a, 0x0d, 0xb1, 0x90, 0x66, 0xe9, 0x50, 0x29, 0x3a, 0x26, 0xba, 0x98, 0xf7,
+	0x2e, 0xee, 0xd5, 0xea, 0xd9, 0x3e, 0xd9, 0x4e, 0x75, 0xf9, 0x0a, 0x4c, 0x7c, 0x0c, 0xc6, 0x0e,
+	0x7c, 0x88, 0x6e, 0x54, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0xd0, 0x0f, 0xc1, 0x82, 0xce, 0x3e, 0xa5,
+	0x15, 0x03, 0x12, 0x22, 0x5d, 0xee, 0xcf, 0xf3, 0xd8, 0xbf, 0xe7, 0xb9, 0x93, 0x5f, 0x1c, 0x65,
+	0xda, 0x4a, 0x6d, 0x63, 0x29, 0x94, 0x8b, 0xcf, 0xf6, 0x52, 0xee, 0x60, 0xcf, 0xbf, 0xf4, 0x4a,
+	0xa3, 0x9d, 0x26, 0x2b, 0xc1, 0xef, 0x79, 0xa9, 0xf1, 0x57, 0x9f, 0xe5, 0x3a, 0xd7, 0xde, 0x8f,
+	0xeb, 0xa7, 0xb0, 0x74, 0xf5, 0x45, 0x58, 0xca, 0x82, 0xd1, 0xec, 0x0b, 0xd6, 0x32, 0x48, 0xa1,
+	0x74, 0xec, 0xaf, 0x41, 0xda, 0xfc, 0x8a, 0x70, 0xe7, 0x50, 0x28, 0xc7, 0x0d, 0x39, 0xc2, 0x5d,
+	0xa1, 0x8e, 0x0b, 0x70, 0x42, 0x2b, 0x8a, 0x36, 0xd0, 0x76, 0xb7, 0xff, 0xe6, 0xe2, 0x6a, 0xbd,
+	0xf5, 0xe3, 0x6a, 0x7d, 0x2b, 0x17, 0xee, 0x64, 0x9c, 0xf6, 0x32, 0x2d, 0x1b, 0x62, 0x73, 0xdb,
+	0xb5, 0xa3, 0xd3, 0xd8, 0x4d, 0x4a, 0x6e, 0x7b, 0x03, 0x9e, 0x7d, 0xfb, 0xb2, 0x8b, 0x9b, 0xc0,
+	0x01, 0xcf, 0x92, 0x1b, 0x1c, 0x11, 0x78, 0x19, 0x94, 0x1a, 0x43, 0x51, 0xd7, 0x3a, 0x13, 0x56,
+	0x68, 0x65, 0xe9, 0xbd, 0x05, 0x64, 0x3c, 0x0d, 0xd8, 0xe1, 0x9c, 0xba, 0xf9, 0xab, 0x8d, 0x3b,
+	0x43, 0x30, 0x20, 0x2d, 0x59, 0xc3, 0xb8, 0xfe, 0x61, 0x6c, 0xc4, 0x95, 0x96, 0xe1, 0x93, 0x92,
+	0x6e, 0xad, 0x0c, 0x6a, 0x81, 0x8c, 0xf1, 0xf3, 0x79, 0x43, 0x66, 0xc0, 0x71, 0x96, 0x9d, 0x80,
+	0xca, 0x79, 0x53, 0xec, 0xed, 0xff, 0x14, 0xfb, 0x7c, 0x7d, 0xbe, 0x83, 0x92, 0x95, 0x39, 0x3f,
+	0x01, 0xc7, 0xf7, 0x3d, 0x9d, 0x1c, 0xe3, 0xa5, 0x9b, 0x58, 0x09, 0x15, 0xbd, 0xbf, 0xa8, 0xb8,
+	0xc7, 0x73, 0xee, 0x21, 0x54, 0x7f, 0xe4, 0x08, 0x45, 0xdb, 0x77, 0x90, 0x23, 0x14, 0x49, 0xf1,
+	0xa3, 0x5c, 0x43, 0xc1, 0x52, 0xad, 0x46, 0x7c, 0x44, 0x1f, 0x2c, 0x2a, 0x05, 0xd7, 0xd4, 0xbe,
+	0x87, 0x92, 0x2d, 0xfc, 0x24, 0x2d, 0x74, 0x76, 0x6a, 0x59, 0xc9, 0x0d, 0x9b, 0x70, 0x30, 0xb4,
+	0xb3, 0x81, 0xb6, 0xdb, 0xc9, 0x52, 0x90, 0x87, 0xdc, 0xbc, 0xe7, 0x60, 0xc8, 0x07, 0x8c, 0x25,
+	0x54, 0xcc, 0x8e, 0xcb, 0xb2, 0x98, 0xd0, 0x87, 0xff, 0x5c, 0xe5, 0x40, 0xb9, 0x5b, 0x55, 0x0e,
+	0x94, 0x0b, 0x55, 0xba, 0x12, 0xaa, 0x77, 0x9e, 0xf9, 0x7a, 0xed, 0xe3, 0xf5, 0xf9, 0x0e, 0xbd,
+	0xb5, 0xb1, 0x0a, 0x43, 0x1b, 0x8e, 0x5c, 0x7f, 0xff, 0x62, 0x1a, 0xa1, 0xcb, 0x69, 0x84, 0x7e,
+	0x4e, 0x23, 0xf4, 0x69, 0x16, 0xb5, 0x2e, 0x67, 0x51, 0xeb, 0xfb, 0x2c, 0x6a, 0x1d, 0xbd, 0xfc,
+	0x6b, 0x7c, 0x43, 0xf1, 0x2d, 0xd2, 0x8e, 0x9f, 0xcd, 0x57, 0xbf, 0x07, 0x00, 0xbc, 0xa5, 0x99,
+	0xd0, 0x16, 0x04, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +236,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -331,6 +346,8 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +691,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -13,6 +13,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+// Flag names and values
+const (
+	FlagClassName        = "name"
+	FlagClassSymbol      = "symbol"
+	FlagClassDescription = "description"
+	FlagURI              = "uri"
+	FlagURIHash          = "uri-hash"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +35,9 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdNewClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
 	)
 
 	return nftTxCmd
@@ -59,3 +71,118 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdNewClass creates a CLI command for MsgNewClass.
+func NewCmdNewClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "new-class [class-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s new-class <class-id> --name <name> --symbol <symbol> --uri <uri> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			class := nft.Class{Id: args[0]}
+			if class.Name, err = cmd.Flags().GetString(FlagClassName); err != nil {
+				return err
+			}
+			if class.Symbol, err = cmd.Flags().GetString(FlagClassSymbol); err != nil {
+				return err
+			}
+			if class.Description, err = cmd.Flags().GetString(FlagClassDescription); err != nil {
+				return err
+			}
+			if class.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if class.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+
+			msg := nft.MsgNewClass{
+				Class:  &class,
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagClassName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagClassSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagClassDescription, "", "A brief description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdMint creates a CLI command for MsgMint.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft of an existing class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			token := nft.NFT{ClassId: args[0], Id: args[1]}
+			if token.Uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+				return err
+			}
+			if token.UriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Nft:      &token,
+				Sender:   clientCtx.GetFromAddress().String(),
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdBurn creates a CLI command for MsgBurn.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn an owned nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				ClassId: args[0],
+				Id:      args[1],
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,15 +1,27 @@
 package nft
 
 import (
+	"cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	classes := make(map[string]bool, len(data.Classes))
 	for _, class := range data.Classes {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		classes[class.Id] = true
+	}
+	for _, classOwner := range data.ClassOwners {
+		if !classes[classOwner.ClassId] {
+			return errors.Wrap(ErrClassNotExists, classOwner.ClassId)
+		}
+		if _, err := sdk.AccAddressFromBech32(classOwner.Owner); err != nil {
+			return err
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -156,3 +156,6 @@ require (
 // Fix upstream GHSA-h395-qcrw-5vmq vulnerability.
 // TODO Remove it: https://github.com/cosmos/cosmos-sdk/issues/10409
 replace github.com/gin-gonic/gin => github.com/gin-gonic/gin v1.8.1
+
+// TODO: remove once the nft module protos are part of a tagged api release.
+replace cosmossdk.io/api => ../../api
@@ -67,3 +67,38 @@ func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(classStoreKey(classID))
 }
+
+// SetClassOwner defines a method for setting the owner of an exist nft class
+func (k Keeper) SetClassOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(classOwnerStoreKey(classID), owner.Bytes())
+	return nil
+}
+
+// GetClassOwner defines a method for returning the owner of the specified class.
+// Classes which were not created through Msg/NewClass have no owner.
+func (k Keeper) GetClassOwner(ctx sdk.Context, classID string) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(classOwnerStoreKey(classID))
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// GetClassOwners defines a method for returning the owners of all classes
+func (k Keeper) GetClassOwners(ctx sdk.Context) (owners []*nft.ClassOwner) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, ClassOwnerKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		owners = append(owners, &nft.ClassOwner{
+			ClassId: string(iterator.Key()[len(ClassOwnerKey):]),
+			Owner:   sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return
+}
@@ -15,6 +15,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, classOwner := range data.ClassOwners {
+		owner := sdk.MustAccAddressFromBech32(classOwner.Owner)
+
+		if err := k.SetClassOwner(ctx, classOwner.ClassId, owner); err != nil {
+			panic(err)
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			owner := sdk.MustAccAddressFromBech32(entry.Owner)
@@ -56,7 +63,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:     classes,
+		Entries:     entries,
+		ClassOwners: k.GetClassOwners(ctx),
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper of the nft store
@@ -12,20 +13,46 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+
+	// the address capable of creating classes and minting nfts through the
+	// Msg service when the permissions require it. Usually the gov module account.
+	authority   string
+	permissions nft.MsgPermissions
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(key storetypes.StoreKey,
 	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	authority string, permissions nft.MsgPermissions,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
 		panic("the nft module account has not been set")
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	if err := permissions.Validate(); err != nil {
+		panic(err)
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: key,
-		bk:       bk,
+		cdc:         cdc,
+		storeKey:    key,
+		bk:          bk,
+		authority:   authority,
+		permissions: permissions,
 	}
 }
+
+// GetAuthority returns the x/nft module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetMsgPermissions returns the permissions applied by the nft Msg service.
+func (k Keeper) GetMsgPermissions() nft.MsgPermissions {
+	return k.permissions
+}
@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassOwnerKey        = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -31,6 +32,14 @@ func classStoreKey(classID string) []byte {
 	return key
 }
 
+// classOwnerStoreKey returns the byte representation of the class owner key
+func classOwnerStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassOwnerKey)+len(classID))
+	copy(key, ClassOwnerKey)
+	copy(key[len(ClassOwnerKey):], classID)
+	return key
+}
+
 // nftStoreKey returns the byte representation of the nft
 func nftStoreKey(classID string) []byte {
 	key := make([]byte, len(NFTKey)+len(classID)+len(Delimiter))
@@ -10,10 +10,20 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -42,3 +52,102 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// NewClass implements NewClass method of the types.MsgServer.
+func (k msgServer) NewClass(goCtx context.Context, msg *nft.MsgNewClass) (*nft.MsgNewClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Class == nil {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if !k.isAllowed(ctx, k.permissions.ClassCreator, msg.Class.Id, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to create nft classes", sender)
+	}
+
+	if err := k.SaveClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetClassOwner(ctx, msg.Class.Id, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventNewClass{
+		ClassId: msg.Class.Id,
+		Owner:   msg.Sender,
+	})
+	return &nft.MsgNewClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Nft == nil {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if !k.isAllowed(ctx, k.permissions.Minter, msg.Nft.ClassId, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", sender, msg.Nft.ClassId)
+	}
+
+	if err := k.Keeper.Mint(ctx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasNFT(ctx, msg.ClassId, msg.Id) {
+		return nil, errorsmod.Wrapf(nft.ErrNFTNotExists, "not found nft: class: %s, id: %s", msg.ClassId, msg.Id)
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// isAllowed returns true if the sender satisfies the given permission for
+// the given class.
+func (k msgServer) isAllowed(ctx sdk.Context, permission nft.Permission, classID string, sender sdk.AccAddress) bool {
+	switch permission {
+	case nft.PermissionAnyone:
+		return true
+	case nft.PermissionAuthority:
+		return sender.String() == k.authority
+	case nft.PermissionClassOwner:
+		owner, found := k.GetClassOwner(ctx, classID)
+		return found && owner.Equals(sender)
+	default:
+		return false
+	}
+}
@@ -98,7 +98,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -108,3 +108,184 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestMsgNewClass() {
+	testCases := []struct {
+		name   string
+		req    *nft.MsgNewClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "unauthorized class creator",
+			req: &nft.MsgNewClass{
+				Class:  &ExpClass,
+				Sender: s.addrs[0].String(),
+			},
+			expErr: true,
+			errMsg: "unauthorized",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgNewClass{
+				Class:  &ExpClass,
+				Sender: s.authority.String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "class already exists",
+			req: &nft.MsgNewClass{
+				Class:  &ExpClass,
+				Sender: s.authority.String(),
+			},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.NewClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	owner, has := s.nftKeeper.GetClassOwner(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.authority, owner)
+}
+
+func (s *TestSuite) TestMsgMint() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+	err = s.nftKeeper.SetClassOwner(s.ctx, testClassID, s.addrs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgMint
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "sender is not the class owner",
+			req: &nft.MsgMint{
+				Nft:      &ExpNFT,
+				Sender:   s.addrs[1].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "unauthorized",
+		},
+		{
+			name: "class does not exist",
+			req: &nft.MsgMint{
+				Nft:      &nft.NFT{ClassId: "invalid ClassId", Id: testID},
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "unauthorized",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgMint{
+				Nft:      &ExpNFT,
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "nft already exists",
+			req: &nft.MsgMint{
+				Nft:      &ExpNFT,
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "nft already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Mint(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestMsgBurn() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBurn
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "nft not exists",
+			req: &nft.MsgBurn{
+				ClassId: testClassID,
+				Id:      "not-exist",
+				Sender:  s.addrs[0].String(),
+			},
+			expErr: true,
+			errMsg: nft.ErrNFTNotExists.Error(),
+		},
+		{
+			name: "unauthorized sender",
+			req: &nft.MsgBurn{
+				ClassId: testClassID,
+				Id:      testID,
+				Sender:  s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of nft %s", s.addrs[1].String(), testID),
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgBurn{
+				ClassId: testClassID,
+				Id:      testID,
+				Sender:  s.addrs[0].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Burn(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+}
@@ -0,0 +1,45 @@
+package module
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	nftv1beta1 "cosmossdk.io/api/cosmos/nft/v1beta1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+// The tx options are used when the application does not register the
+// hand-written commands returned by GetTxCmd.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: nftv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Send",
+					Use:            "send [class-id] [nft-id] [receiver]",
+					Short:          "Transfer ownership of nft",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "class_id"}, {ProtoField: "id"}, {ProtoField: "receiver"}},
+				},
+				{
+					RpcMethod: "NewClass",
+					Use:       "new-class --class [class-json]",
+					Short:     "Create a new nft class owned by the sender",
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [receiver] --nft [nft-json]",
+					Short:          "Mint a new nft of an existing class",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "receiver"}},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [class-id] [nft-id]",
+					Short:          "Burn an owned nft",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "class_id"}, {ProtoField: "id"}},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: nftv1beta1.Query_ServiceDesc.ServiceName,
+		},
+	}
+}
@@ -19,6 +19,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 
@@ -47,7 +49,7 @@ func (AppModuleBasic) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	nft.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	nft.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
@@ -181,6 +183,7 @@ func init() {
 type NftInputs struct {
 	depinject.In
 
+	Config   *modulev1.Module
 	Key      *store.KVStoreKey
 	Cdc      codec.Codec
 	Registry cdctypes.InterfaceRegistry
@@ -197,7 +200,22 @@ type NftOutputs struct {
 }
 
 func ProvideModule(in NftInputs) NftOutputs {
-	k := keeper.NewKeeper(in.Key, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	// the module config permissions share their values with nft.Permission
+	permissions := nft.DefaultMsgPermissions()
+	if in.Config.ClassCreator != modulev1.Module_PERMISSION_UNSPECIFIED {
+		permissions.ClassCreator = nft.Permission(in.Config.ClassCreator)
+	}
+	if in.Config.Minter != modulev1.Module_PERMISSION_UNSPECIFIED {
+		permissions.Minter = nft.Permission(in.Config.Minter)
+	}
+
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.AccountKeeper, in.BankKeeper, authority.String(), permissions)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return NftOutputs{NFTKeeper: k, Module: m}
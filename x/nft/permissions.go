@@ -0,0 +1,67 @@
+package nft
+
+import "fmt"
+
+// Permission defines which accounts are allowed to perform an action
+// through the nft Msg service.
+type Permission int32
+
+const (
+	// PermissionAuthority only allows the module authority.
+	PermissionAuthority Permission = iota + 1
+	// PermissionClassOwner only allows the owner of the class, i.e. the
+	// account which created the class through Msg/NewClass.
+	PermissionClassOwner
+	// PermissionAnyone allows any account.
+	PermissionAnyone
+)
+
+// String implements the fmt.Stringer interface.
+func (p Permission) String() string {
+	switch p {
+	case PermissionAuthority:
+		return "authority"
+	case PermissionClassOwner:
+		return "class owner"
+	case PermissionAnyone:
+		return "anyone"
+	default:
+		return fmt.Sprintf("unknown permission %d", int32(p))
+	}
+}
+
+// MsgPermissions defines which accounts are allowed to create classes and to
+// mint nfts through the nft Msg service.
+type MsgPermissions struct {
+	// ClassCreator defines who is allowed to create classes through Msg/NewClass.
+	ClassCreator Permission
+	// Minter defines who is allowed to mint nfts through Msg/Mint.
+	Minter Permission
+}
+
+// DefaultMsgPermissions returns the default permissions: only the module
+// authority can create classes, and only the owner of a class can mint nfts
+// of that class.
+func DefaultMsgPermissions() MsgPermissions {
+	return MsgPermissions{
+		ClassCreator: PermissionAuthority,
+		Minter:       PermissionClassOwner,
+	}
+}
+
+// Validate checks that the permissions are well-formed.
+func (p MsgPermissions) Validate() error {
+	switch p.ClassCreator {
+	case PermissionAuthority, PermissionAnyone:
+	default:
+		return fmt.Errorf("invalid class creator permission: %s", p.ClassCreator)
+	}
+
+	switch p.Minter {
+	case PermissionAuthority, PermissionClassOwner, PermissionAnyone:
+	default:
+		return fmt.Errorf("invalid minter permission: %s", p.Minter)
+	}
+
+	return nil
+}